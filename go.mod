@@ -1,8 +1,14 @@
 module github.com/pasdam/mockit
 
-go 1.13
+go 1.21
 
 require (
 	bou.ke/monkey v1.0.2
 	github.com/stretchr/testify v1.8.2
 )
+
+require (
+	github.com/davecgh/go-spew v1.1.1 // indirect
+	github.com/pmezard/go-difflib v1.0.0 // indirect
+	gopkg.in/yaml.v3 v3.0.1 // indirect
+)
@@ -0,0 +1,16 @@
+package format
+
+import (
+	"reflect"
+	"strings"
+
+	"github.com/pasdam/mockit/internal/utils"
+)
+
+// FuncName returns the name of the function, qualified by the name of its
+// package (i.e. argument.Any)
+func FuncName(fn reflect.Value) string {
+	name := utils.MethodFullyQualifiedName(fn)
+	name = name[strings.LastIndex(name, "/")+1:]
+	return strings.TrimSuffix(name, "-fm")
+}
@@ -0,0 +1,19 @@
+package format_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pasdam/mockit/internal/format"
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFuncName(t *testing.T) {
+	captor := &argument.Captor{}
+
+	assert.Equal(t, "argument.Any", format.FuncName(reflect.ValueOf(argument.Any)))
+	assert.Equal(t, "strings.ToUpper", format.FuncName(reflect.ValueOf(strings.ToUpper)))
+	assert.Equal(t, "argument.(*Captor).Capture", format.FuncName(reflect.ValueOf(captor.Capture)))
+}
@@ -1,24 +1,21 @@
 package format
 
 import (
-	"fmt"
 	"reflect"
 	"strings"
-
-	"github.com/pasdam/mockit/internal/utils"
 )
 
-// PrintCall prints the specified method call
-func PrintCall(target *reflect.Value, in []reflect.Value) string {
+// PrintCall prints the call of the method with the specified name
+func PrintCall(name string, in []reflect.Value) string {
 	var str strings.Builder
 
-	str.WriteString(utils.MethodName(utils.MethodFullyQualifiedName(*target)))
+	str.WriteString(name)
 	str.WriteString("(")
 	if len(in) > 0 {
-		str.WriteString(fmt.Sprintf("%+v", in[0]))
+		str.WriteString(printValue(in[0]))
 		for i := 1; i < len(in); i++ {
 			str.WriteString(", ")
-			str.WriteString(fmt.Sprintf("%+v", in[i]))
+			str.WriteString(printValue(in[i]))
 		}
 	}
 	str.WriteString(")")
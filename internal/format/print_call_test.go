@@ -1,10 +1,14 @@
 package format_test
 
 import (
+	"net/http"
 	"reflect"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/pasdam/mockit/internal/format"
+	"github.com/pasdam/mockit/matchers/argument"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,8 +20,8 @@ func Test_printCall(t *testing.T) {
 		d float64
 	}
 	type args struct {
-		target reflect.Value
-		in     []reflect.Value
+		name string
+		in   []reflect.Value
 	}
 	tests := []struct {
 		name string
@@ -27,39 +31,39 @@ func Test_printCall(t *testing.T) {
 		{
 			name: "Nil arguments",
 			args: args{
-				target: reflect.ValueOf(Test_printCall),
-				in:     nil,
+				name: "Test_printCall",
+				in:   nil,
 			},
 			want: "Test_printCall()",
 		},
 		{
 			name: "Empty arguments",
 			args: args{
-				target: reflect.ValueOf(format.PrintCall),
-				in:     []reflect.Value{},
+				name: "PrintCall",
+				in:   []reflect.Value{},
 			},
 			want: "PrintCall()",
 		},
 		{
 			name: "With 1 argument",
 			args: args{
-				target: reflect.ValueOf(format.PrintCall),
-				in:     []reflect.Value{reflect.ValueOf("some-arg")},
+				name: "PrintCall",
+				in:   []reflect.Value{reflect.ValueOf("some-arg")},
 			},
 			want: "PrintCall(some-arg)",
 		},
 		{
 			name: "With 2 arguments",
 			args: args{
-				target: reflect.ValueOf(format.PrintCall),
-				in:     []reflect.Value{reflect.ValueOf("some-arg-1"), reflect.ValueOf("some-arg-2")},
+				name: "PrintCall",
+				in:   []reflect.Value{reflect.ValueOf("some-arg-1"), reflect.ValueOf("some-arg-2")},
 			},
 			want: "PrintCall(some-arg-1, some-arg-2)",
 		},
 		{
 			name: "With struct argument",
 			args: args{
-				target: reflect.ValueOf(format.PrintCall),
+				name: "PrintCall",
 				in: []reflect.Value{reflect.ValueOf(t1{
 					a: "some-a-val",
 					b: 123,
@@ -69,10 +73,34 @@ func Test_printCall(t *testing.T) {
 			},
 			want: "PrintCall({a:some-a-val b:123 c:true d:45.6})",
 		},
+		{
+			name: "With function argument",
+			args: args{
+				name: "PrintCall",
+				in:   []reflect.Value{reflect.ValueOf(argument.Any), reflect.ValueOf(strings.ToUpper)},
+			},
+			want: "PrintCall(argument.Any, strings.ToUpper)",
+		},
+		{
+			name: "With self-describing matcher argument",
+			args: args{
+				name: "PrintCall",
+				in:   []reflect.Value{reflect.ValueOf(argument.AnyOf("a", "b")), reflect.ValueOf(argument.IsA[*http.Request]())},
+			},
+			want: `PrintCall(AnyOf("a", "b"), IsA(*http.Request))`,
+		},
+		{
+			name: "With stringer argument",
+			args: args{
+				name: "PrintCall",
+				in:   []reflect.Value{reflect.ValueOf(time.Second)},
+			},
+			want: "PrintCall(1s)",
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			got := format.PrintCall(&tt.args.target, tt.args.in)
+			got := format.PrintCall(tt.args.name, tt.args.in)
 
 			assert.Equal(t, tt.want, got)
 		})
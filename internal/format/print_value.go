@@ -0,0 +1,14 @@
+package format
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// printValue prints the value, functions (i.e. matchers) are printed by name
+func printValue(value reflect.Value) string {
+	if value.Kind() == reflect.Func && !value.IsNil() {
+		return FuncName(value)
+	}
+	return fmt.Sprintf("%+v", value)
+}
@@ -0,0 +1,6 @@
+package goroutines
+
+// Current returns the id of the current goroutine
+func Current() uint64 {
+	return parse(stack(false))[0].id
+}
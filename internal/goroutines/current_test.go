@@ -0,0 +1,24 @@
+package goroutines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Current(t *testing.T) {
+	ids := make(chan uint64, 2)
+	for i := 0; i < 2; i++ {
+		go func() {
+			ids <- Current()
+		}()
+	}
+
+	self := Current()
+	first, second := <-ids, <-ids
+
+	assert.NotZero(t, self)
+	assert.NotEqual(t, self, first)
+	assert.NotEqual(t, self, second)
+	assert.NotEqual(t, first, second)
+}
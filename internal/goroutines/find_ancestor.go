@@ -0,0 +1,16 @@
+package goroutines
+
+// FindAncestor returns the id of the closest goroutine, starting from the
+// current one and going through its ancestors, that satisfies the match
+// function
+func FindAncestor(match func(id uint64) bool) (uint64, bool) {
+	self := parse(stack(false))[0]
+	cacheParent(self.id, self.parent)
+
+	for id := self.id; id != 0; id = parentOf(id) {
+		if match(id) {
+			return id, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,52 @@
+package goroutines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FindAncestor(t *testing.T) {
+	self := Current()
+	type result struct {
+		id    uint64
+		found bool
+	}
+	results := make(chan result)
+
+	go func() {
+		// the grandchild runs while the child is still alive
+		grandchildResults := make(chan result)
+		go func() {
+			id, found := FindAncestor(func(id uint64) bool {
+				return id == self
+			})
+			grandchildResults <- result{id: id, found: found}
+		}()
+		results <- <-grandchildResults
+	}()
+
+	got := <-results
+	assert.True(t, got.found)
+	assert.Equal(t, self, got.id)
+}
+
+func Test_FindAncestor_shouldReturnTheCurrentGoroutineIfItMatches(t *testing.T) {
+	self := Current()
+
+	id, found := FindAncestor(func(id uint64) bool {
+		return true
+	})
+
+	assert.True(t, found)
+	assert.Equal(t, self, id)
+}
+
+func Test_FindAncestor_shouldReturnFalseIfNoAncestorMatches(t *testing.T) {
+	id, found := FindAncestor(func(id uint64) bool {
+		return false
+	})
+
+	assert.False(t, found)
+	assert.Zero(t, id)
+}
@@ -0,0 +1,14 @@
+package goroutines
+
+// FindByFrame returns the id of a goroutine with a frame, in its stack trace,
+// starting with the specified prefix, i.e. "testing.tRunner(0xc000102340,"
+func FindByFrame(prefix string) (uint64, bool) {
+	for _, g := range parse(stack(true)) {
+		for _, frame := range g.frames {
+			if hasPrefix([]byte(frame), prefix) {
+				return g.id, true
+			}
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,33 @@
+package goroutines
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FindByFrame(t *testing.T) {
+	self := Current()
+	type result struct {
+		id    uint64
+		found bool
+	}
+	results := make(chan result)
+
+	go func() {
+		id, found := FindByFrame(fmt.Sprintf("testing.tRunner(%p,", t))
+		results <- result{id: id, found: found}
+	}()
+
+	got := <-results
+	assert.True(t, got.found)
+	assert.Equal(t, self, got.id)
+}
+
+func Test_FindByFrame_shouldReturnFalseIfNoFrameMatches(t *testing.T) {
+	id, found := FindByFrame("some/pkg.notExistingFunction(")
+
+	assert.False(t, found)
+	assert.Zero(t, id)
+}
@@ -0,0 +1,21 @@
+package goroutines
+
+const hexDigits = "0123456789abcdef"
+
+// FormatPointer formats the pointer as it is printed in the stack traces,
+// i.e. "0xc000102340"
+func FormatPointer(pointer uintptr) string {
+	if pointer == 0 {
+		return "0x0"
+	}
+
+	var buf [2 + 2*8]byte
+	i := len(buf)
+	for ; pointer > 0; pointer >>= 4 {
+		i--
+		buf[i] = hexDigits[pointer&0xf]
+	}
+	i -= 2
+	buf[i], buf[i+1] = '0', 'x'
+	return string(buf[i:])
+}
@@ -0,0 +1,15 @@
+package goroutines
+
+import (
+	"fmt"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FormatPointer(t *testing.T) {
+	assert.Equal(t, "0x0", FormatPointer(0))
+	assert.Equal(t, "0x1f", FormatPointer(0x1f))
+	assert.Equal(t, fmt.Sprintf("%p", t), FormatPointer(uintptr(unsafe.Pointer(t))))
+}
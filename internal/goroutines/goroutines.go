@@ -0,0 +1,19 @@
+// Package goroutines identifies the goroutines of the process, and their
+// ancestors, parsing their stack traces.
+//
+// Ancestors are identified through the "created by ... in goroutine N" line,
+// which is available since Go 1.21, the minimum version required by the module.
+// The parents are cached, as the goroutines' ids are not reused, so an ancestor
+// that has exited is identified only if it was looked up before.
+//
+// The package doesn't use the standard library functions to parse the stack
+// traces (i.e. strings or strconv), as they might be mocked while it is used.
+package goroutines
+
+// goroutine contains the information parsed from the stack trace of a
+// goroutine
+type goroutine struct {
+	frames []string
+	id     uint64
+	parent uint64
+}
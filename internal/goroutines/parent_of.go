@@ -0,0 +1,48 @@
+package goroutines
+
+import "sync"
+
+// maxParents is the maximum number of cached parents, the cache is cleared
+// when it is reached
+const maxParents = 1 << 16
+
+var (
+	parentsLock sync.Mutex
+	// parents contains the id of the parent of each known goroutine, 0 if it
+	// has none or if it exited before being looked up
+	parents = make(map[uint64]uint64)
+)
+
+// parentOf returns the id of the parent of the goroutine, 0 if it has none or
+// if it can't be identified; the stack traces of all the goroutines are parsed
+// only if the goroutine is not cached yet
+func parentOf(id uint64) uint64 {
+	parentsLock.Lock()
+	defer parentsLock.Unlock()
+
+	if parent, found := parents[id]; found {
+		return parent
+	}
+
+	if len(parents) >= maxParents {
+		parents = make(map[uint64]uint64)
+	}
+	for _, g := range parse(stack(true)) {
+		parents[g.id] = g.parent
+	}
+	parent := parents[id]
+	parents[id] = parent
+	return parent
+}
+
+// cacheParent stores the parent of the goroutine, i.e. parsed from its own
+// stack trace
+func cacheParent(id uint64, parent uint64) {
+	parentsLock.Lock()
+	defer parentsLock.Unlock()
+
+	if len(parents) >= maxParents {
+		parents = make(map[uint64]uint64)
+	}
+	parents[id] = parent
+}
@@ -0,0 +1,43 @@
+package goroutines
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parentOf(t *testing.T) {
+	self := Current()
+	child := make(chan uint64)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		child <- Current()
+		<-done
+	}()
+	id := <-child
+
+	assert.Equal(t, self, parentOf(id))
+
+	parentsLock.Lock()
+	cached, found := parents[id]
+	parentsLock.Unlock()
+	assert.True(t, found)
+	assert.Equal(t, self, cached)
+}
+
+func Test_parentOf_shouldReturnZeroForAnUnknownGoroutine(t *testing.T) {
+	assert.Zero(t, parentOf(math.MaxUint64))
+
+	parentsLock.Lock()
+	_, found := parents[math.MaxUint64]
+	parentsLock.Unlock()
+	assert.True(t, found)
+}
+
+func Test_cacheParent(t *testing.T) {
+	cacheParent(math.MaxUint64-1, 42)
+
+	assert.Equal(t, uint64(42), parentOf(math.MaxUint64-1))
+}
@@ -0,0 +1,79 @@
+package goroutines
+
+const (
+	createdByPrefix   = "created by "
+	goroutinePrefix   = "goroutine "
+	parentIDSeparator = " in goroutine "
+)
+
+// parse returns the goroutines in the stack trace
+func parse(trace []byte) []*goroutine {
+	var result []*goroutine
+	var current *goroutine
+	for start := 0; start < len(trace); {
+		end := start
+		for end < len(trace) && trace[end] != '\n' {
+			end++
+		}
+		line := trace[start:end]
+		start = end + 1
+
+		switch {
+		case hasPrefix(line, goroutinePrefix):
+			id, ok := parseUint(line[len(goroutinePrefix):])
+			if !ok {
+				current = nil
+				continue
+			}
+			current = &goroutine{id: id}
+			result = append(result, current)
+
+		case current == nil || len(line) == 0 || line[0] == '\t':
+			continue
+
+		case hasPrefix(line, createdByPrefix):
+			if i := lastIndex(line, parentIDSeparator); i >= 0 {
+				current.parent, _ = parseUint(line[i+len(parentIDSeparator):])
+			}
+
+		default:
+			current.frames = append(current.frames, string(line))
+		}
+	}
+	return result
+}
+
+// hasPrefix returns true if the line starts with the prefix
+func hasPrefix(line []byte, prefix string) bool {
+	if len(line) < len(prefix) {
+		return false
+	}
+	for i := 0; i < len(prefix); i++ {
+		if line[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// lastIndex returns the index of the last occurrence of the separator in the
+// line, -1 if not found
+func lastIndex(line []byte, separator string) int {
+	for i := len(line) - len(separator); i >= 0; i-- {
+		if hasPrefix(line[i:], separator) {
+			return i
+		}
+	}
+	return -1
+}
+
+// parseUint parses the decimal number at the beginning of the line, returns
+// false if the line doesn't start with a digit
+func parseUint(line []byte) (uint64, bool) {
+	var result uint64
+	i := 0
+	for ; i < len(line) && line[i] >= '0' && line[i] <= '9'; i++ {
+		result = result*10 + uint64(line[i]-'0')
+	}
+	return result, i > 0
+}
@@ -0,0 +1,68 @@
+package goroutines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_parse(t *testing.T) {
+	trace := []byte(`goroutine 7 [running]:
+some/pkg.TestX.func1(0xc000102340?)
+	/some/pkg/some_test.go:18 +0x73
+testing.tRunner(0xc000102340, 0x6d4a38)
+	/usr/local/go/src/testing/testing.go:2193 +0xea
+created by testing.(*T).Run in goroutine 6
+	/usr/local/go/src/testing/testing.go:2258 +0x4d4
+
+goroutine 1 [chan receive]:
+main.main()
+	_testmain.go:46 +0x9b
+
+goroutine 9 [select]:
+some/pkg.worker()
+	/some/pkg/worker.go:10 +0x20
+created by some/pkg.start
+	/some/pkg/worker.go:5 +0x10
+`)
+
+	got := parse(trace)
+
+	assert.Equal(t, []*goroutine{
+		{
+			frames: []string{"some/pkg.TestX.func1(0xc000102340?)", "testing.tRunner(0xc000102340, 0x6d4a38)"},
+			id:     7,
+			parent: 6,
+		},
+		{
+			frames: []string{"main.main()"},
+			id:     1,
+		},
+		{
+			frames: []string{"some/pkg.worker()"},
+			id:     9,
+		},
+	}, got)
+}
+
+func Test_parse_shouldIgnoreInvalidHeaders(t *testing.T) {
+	got := parse([]byte("goroutine invalid [running]:\nsome/pkg.f()\n"))
+
+	assert.Empty(t, got)
+}
+
+func Test_lastIndex(t *testing.T) {
+	assert.Equal(t, 5, lastIndex([]byte("ab-cd-ef"), "-e"))
+	assert.Equal(t, 0, lastIndex([]byte("ab"), "ab"))
+	assert.Equal(t, -1, lastIndex([]byte("ab"), "abc"))
+}
+
+func Test_parseUint(t *testing.T) {
+	got, ok := parseUint([]byte("123 [running]"))
+	assert.True(t, ok)
+	assert.Equal(t, uint64(123), got)
+
+	got, ok = parseUint([]byte("invalid"))
+	assert.False(t, ok)
+	assert.Zero(t, got)
+}
@@ -0,0 +1,17 @@
+package goroutines
+
+import (
+	"runtime"
+)
+
+// stack returns the stack trace of the current goroutine, or of all of them
+func stack(all bool) []byte {
+	buf := make([]byte, 8192)
+	for {
+		n := runtime.Stack(buf, all)
+		if n < len(buf) {
+			return buf[:n]
+		}
+		buf = make([]byte, 2*len(buf))
+	}
+}
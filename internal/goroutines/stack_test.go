@@ -0,0 +1,16 @@
+package goroutines
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_stack(t *testing.T) {
+	self := stack(false)
+	all := stack(true)
+
+	assert.Contains(t, string(self), "goroutines.Test_stack(")
+	assert.Contains(t, string(all), "goroutines.Test_stack(")
+	assert.Contains(t, string(all), "main.main()")
+}
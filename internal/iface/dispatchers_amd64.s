@@ -0,0 +1,1541 @@
+// Code generated by gen.go; DO NOT EDIT.
+
+#include "textflag.h"
+
+TEXT ·dispatch0(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 0(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch1(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 8(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch2(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 16(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch3(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 24(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch4(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 32(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch5(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 40(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch6(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 48(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch7(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 56(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch8(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 64(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch9(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 72(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch10(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 80(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch11(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 88(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch12(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 96(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch13(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 104(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch14(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 112(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch15(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 120(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch16(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 128(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch17(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 136(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch18(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 144(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch19(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 152(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch20(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 160(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch21(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 168(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch22(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 176(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch23(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 184(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch24(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 192(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch25(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 200(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch26(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 208(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch27(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 216(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch28(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 224(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch29(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 232(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch30(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 240(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch31(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 248(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch32(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 256(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch33(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 264(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch34(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 272(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch35(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 280(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch36(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 288(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch37(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 296(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch38(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 304(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch39(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 312(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch40(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 320(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch41(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 328(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch42(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 336(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch43(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 344(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch44(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 352(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch45(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 360(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch46(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 368(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch47(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 376(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch48(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 384(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch49(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 392(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch50(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 400(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch51(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 408(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch52(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 416(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch53(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 424(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch54(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 432(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch55(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 440(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch56(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 448(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch57(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 456(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch58(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 464(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch59(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 472(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch60(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 480(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch61(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 488(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch62(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 496(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch63(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 504(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch64(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 512(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch65(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 520(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch66(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 528(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch67(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 536(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch68(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 544(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch69(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 552(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch70(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 560(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch71(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 568(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch72(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 576(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch73(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 584(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch74(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 592(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch75(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 600(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch76(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 608(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch77(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 616(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch78(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 624(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch79(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 632(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch80(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 640(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch81(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 648(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch82(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 656(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch83(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 664(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch84(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 672(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch85(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 680(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch86(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 688(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch87(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 696(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch88(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 704(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch89(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 712(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch90(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 720(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch91(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 728(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch92(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 736(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch93(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 744(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch94(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 752(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch95(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 760(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch96(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 768(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch97(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 776(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch98(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 784(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch99(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 792(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch100(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 800(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch101(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 808(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch102(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 816(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch103(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 824(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch104(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 832(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch105(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 840(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch106(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 848(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch107(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 856(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch108(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 864(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch109(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 872(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch110(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 880(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch111(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 888(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch112(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 896(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch113(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 904(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch114(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 912(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch115(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 920(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch116(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 928(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch117(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 936(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch118(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 944(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch119(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 952(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch120(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 960(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch121(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 968(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch122(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 976(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch123(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 984(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch124(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 992(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch125(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1000(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch126(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1008(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch127(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1016(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch128(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1024(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch129(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1032(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch130(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1040(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch131(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1048(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch132(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1056(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch133(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1064(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch134(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1072(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch135(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1080(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch136(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1088(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch137(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1096(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch138(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1104(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch139(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1112(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch140(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1120(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch141(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1128(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch142(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1136(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch143(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1144(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch144(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1152(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch145(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1160(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch146(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1168(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch147(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1176(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch148(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1184(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch149(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1192(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch150(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1200(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch151(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1208(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch152(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1216(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch153(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1224(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch154(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1232(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch155(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1240(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch156(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1248(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch157(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1256(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch158(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1264(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch159(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1272(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch160(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1280(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch161(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1288(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch162(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1296(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch163(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1304(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch164(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1312(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch165(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1320(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch166(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1328(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch167(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1336(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch168(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1344(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch169(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1352(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch170(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1360(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch171(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1368(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch172(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1376(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch173(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1384(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch174(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1392(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch175(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1400(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch176(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1408(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch177(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1416(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch178(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1424(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch179(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1432(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch180(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1440(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch181(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1448(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch182(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1456(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch183(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1464(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch184(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1472(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch185(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1480(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch186(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1488(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch187(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1496(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch188(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1504(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch189(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1512(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch190(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1520(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch191(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1528(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch192(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1536(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch193(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1544(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch194(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1552(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch195(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1560(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch196(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1568(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch197(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1576(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch198(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1584(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch199(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1592(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch200(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1600(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch201(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1608(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch202(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1616(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch203(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1624(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch204(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1632(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch205(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1640(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch206(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1648(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch207(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1656(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch208(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1664(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch209(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1672(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch210(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1680(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch211(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1688(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch212(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1696(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch213(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1704(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch214(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1712(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch215(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1720(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch216(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1728(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch217(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1736(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch218(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1744(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch219(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1752(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch220(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1760(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch221(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1768(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch222(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1776(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch223(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1784(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch224(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1792(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch225(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1800(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch226(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1808(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch227(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1816(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch228(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1824(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch229(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1832(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch230(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1840(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch231(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1848(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch232(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1856(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch233(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1864(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch234(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1872(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch235(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1880(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch236(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1888(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch237(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1896(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch238(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1904(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch239(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1912(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch240(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1920(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch241(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1928(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch242(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1936(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch243(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1944(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch244(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1952(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch245(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1960(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch246(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1968(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch247(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1976(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch248(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1984(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch249(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 1992(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch250(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2000(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch251(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2008(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch252(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2016(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch253(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2024(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch254(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2032(DX), DX
+	JMP (DX)
+
+TEXT ·dispatch255(SB), NOSPLIT|NOFRAME, $0-0
+	MOVQ 16(AX), DX
+	MOVQ 2040(DX), DX
+	JMP (DX)
+
+DATA ·dispatchers+0(SB)/8, $·dispatch0(SB)
+DATA ·dispatchers+8(SB)/8, $·dispatch1(SB)
+DATA ·dispatchers+16(SB)/8, $·dispatch2(SB)
+DATA ·dispatchers+24(SB)/8, $·dispatch3(SB)
+DATA ·dispatchers+32(SB)/8, $·dispatch4(SB)
+DATA ·dispatchers+40(SB)/8, $·dispatch5(SB)
+DATA ·dispatchers+48(SB)/8, $·dispatch6(SB)
+DATA ·dispatchers+56(SB)/8, $·dispatch7(SB)
+DATA ·dispatchers+64(SB)/8, $·dispatch8(SB)
+DATA ·dispatchers+72(SB)/8, $·dispatch9(SB)
+DATA ·dispatchers+80(SB)/8, $·dispatch10(SB)
+DATA ·dispatchers+88(SB)/8, $·dispatch11(SB)
+DATA ·dispatchers+96(SB)/8, $·dispatch12(SB)
+DATA ·dispatchers+104(SB)/8, $·dispatch13(SB)
+DATA ·dispatchers+112(SB)/8, $·dispatch14(SB)
+DATA ·dispatchers+120(SB)/8, $·dispatch15(SB)
+DATA ·dispatchers+128(SB)/8, $·dispatch16(SB)
+DATA ·dispatchers+136(SB)/8, $·dispatch17(SB)
+DATA ·dispatchers+144(SB)/8, $·dispatch18(SB)
+DATA ·dispatchers+152(SB)/8, $·dispatch19(SB)
+DATA ·dispatchers+160(SB)/8, $·dispatch20(SB)
+DATA ·dispatchers+168(SB)/8, $·dispatch21(SB)
+DATA ·dispatchers+176(SB)/8, $·dispatch22(SB)
+DATA ·dispatchers+184(SB)/8, $·dispatch23(SB)
+DATA ·dispatchers+192(SB)/8, $·dispatch24(SB)
+DATA ·dispatchers+200(SB)/8, $·dispatch25(SB)
+DATA ·dispatchers+208(SB)/8, $·dispatch26(SB)
+DATA ·dispatchers+216(SB)/8, $·dispatch27(SB)
+DATA ·dispatchers+224(SB)/8, $·dispatch28(SB)
+DATA ·dispatchers+232(SB)/8, $·dispatch29(SB)
+DATA ·dispatchers+240(SB)/8, $·dispatch30(SB)
+DATA ·dispatchers+248(SB)/8, $·dispatch31(SB)
+DATA ·dispatchers+256(SB)/8, $·dispatch32(SB)
+DATA ·dispatchers+264(SB)/8, $·dispatch33(SB)
+DATA ·dispatchers+272(SB)/8, $·dispatch34(SB)
+DATA ·dispatchers+280(SB)/8, $·dispatch35(SB)
+DATA ·dispatchers+288(SB)/8, $·dispatch36(SB)
+DATA ·dispatchers+296(SB)/8, $·dispatch37(SB)
+DATA ·dispatchers+304(SB)/8, $·dispatch38(SB)
+DATA ·dispatchers+312(SB)/8, $·dispatch39(SB)
+DATA ·dispatchers+320(SB)/8, $·dispatch40(SB)
+DATA ·dispatchers+328(SB)/8, $·dispatch41(SB)
+DATA ·dispatchers+336(SB)/8, $·dispatch42(SB)
+DATA ·dispatchers+344(SB)/8, $·dispatch43(SB)
+DATA ·dispatchers+352(SB)/8, $·dispatch44(SB)
+DATA ·dispatchers+360(SB)/8, $·dispatch45(SB)
+DATA ·dispatchers+368(SB)/8, $·dispatch46(SB)
+DATA ·dispatchers+376(SB)/8, $·dispatch47(SB)
+DATA ·dispatchers+384(SB)/8, $·dispatch48(SB)
+DATA ·dispatchers+392(SB)/8, $·dispatch49(SB)
+DATA ·dispatchers+400(SB)/8, $·dispatch50(SB)
+DATA ·dispatchers+408(SB)/8, $·dispatch51(SB)
+DATA ·dispatchers+416(SB)/8, $·dispatch52(SB)
+DATA ·dispatchers+424(SB)/8, $·dispatch53(SB)
+DATA ·dispatchers+432(SB)/8, $·dispatch54(SB)
+DATA ·dispatchers+440(SB)/8, $·dispatch55(SB)
+DATA ·dispatchers+448(SB)/8, $·dispatch56(SB)
+DATA ·dispatchers+456(SB)/8, $·dispatch57(SB)
+DATA ·dispatchers+464(SB)/8, $·dispatch58(SB)
+DATA ·dispatchers+472(SB)/8, $·dispatch59(SB)
+DATA ·dispatchers+480(SB)/8, $·dispatch60(SB)
+DATA ·dispatchers+488(SB)/8, $·dispatch61(SB)
+DATA ·dispatchers+496(SB)/8, $·dispatch62(SB)
+DATA ·dispatchers+504(SB)/8, $·dispatch63(SB)
+DATA ·dispatchers+512(SB)/8, $·dispatch64(SB)
+DATA ·dispatchers+520(SB)/8, $·dispatch65(SB)
+DATA ·dispatchers+528(SB)/8, $·dispatch66(SB)
+DATA ·dispatchers+536(SB)/8, $·dispatch67(SB)
+DATA ·dispatchers+544(SB)/8, $·dispatch68(SB)
+DATA ·dispatchers+552(SB)/8, $·dispatch69(SB)
+DATA ·dispatchers+560(SB)/8, $·dispatch70(SB)
+DATA ·dispatchers+568(SB)/8, $·dispatch71(SB)
+DATA ·dispatchers+576(SB)/8, $·dispatch72(SB)
+DATA ·dispatchers+584(SB)/8, $·dispatch73(SB)
+DATA ·dispatchers+592(SB)/8, $·dispatch74(SB)
+DATA ·dispatchers+600(SB)/8, $·dispatch75(SB)
+DATA ·dispatchers+608(SB)/8, $·dispatch76(SB)
+DATA ·dispatchers+616(SB)/8, $·dispatch77(SB)
+DATA ·dispatchers+624(SB)/8, $·dispatch78(SB)
+DATA ·dispatchers+632(SB)/8, $·dispatch79(SB)
+DATA ·dispatchers+640(SB)/8, $·dispatch80(SB)
+DATA ·dispatchers+648(SB)/8, $·dispatch81(SB)
+DATA ·dispatchers+656(SB)/8, $·dispatch82(SB)
+DATA ·dispatchers+664(SB)/8, $·dispatch83(SB)
+DATA ·dispatchers+672(SB)/8, $·dispatch84(SB)
+DATA ·dispatchers+680(SB)/8, $·dispatch85(SB)
+DATA ·dispatchers+688(SB)/8, $·dispatch86(SB)
+DATA ·dispatchers+696(SB)/8, $·dispatch87(SB)
+DATA ·dispatchers+704(SB)/8, $·dispatch88(SB)
+DATA ·dispatchers+712(SB)/8, $·dispatch89(SB)
+DATA ·dispatchers+720(SB)/8, $·dispatch90(SB)
+DATA ·dispatchers+728(SB)/8, $·dispatch91(SB)
+DATA ·dispatchers+736(SB)/8, $·dispatch92(SB)
+DATA ·dispatchers+744(SB)/8, $·dispatch93(SB)
+DATA ·dispatchers+752(SB)/8, $·dispatch94(SB)
+DATA ·dispatchers+760(SB)/8, $·dispatch95(SB)
+DATA ·dispatchers+768(SB)/8, $·dispatch96(SB)
+DATA ·dispatchers+776(SB)/8, $·dispatch97(SB)
+DATA ·dispatchers+784(SB)/8, $·dispatch98(SB)
+DATA ·dispatchers+792(SB)/8, $·dispatch99(SB)
+DATA ·dispatchers+800(SB)/8, $·dispatch100(SB)
+DATA ·dispatchers+808(SB)/8, $·dispatch101(SB)
+DATA ·dispatchers+816(SB)/8, $·dispatch102(SB)
+DATA ·dispatchers+824(SB)/8, $·dispatch103(SB)
+DATA ·dispatchers+832(SB)/8, $·dispatch104(SB)
+DATA ·dispatchers+840(SB)/8, $·dispatch105(SB)
+DATA ·dispatchers+848(SB)/8, $·dispatch106(SB)
+DATA ·dispatchers+856(SB)/8, $·dispatch107(SB)
+DATA ·dispatchers+864(SB)/8, $·dispatch108(SB)
+DATA ·dispatchers+872(SB)/8, $·dispatch109(SB)
+DATA ·dispatchers+880(SB)/8, $·dispatch110(SB)
+DATA ·dispatchers+888(SB)/8, $·dispatch111(SB)
+DATA ·dispatchers+896(SB)/8, $·dispatch112(SB)
+DATA ·dispatchers+904(SB)/8, $·dispatch113(SB)
+DATA ·dispatchers+912(SB)/8, $·dispatch114(SB)
+DATA ·dispatchers+920(SB)/8, $·dispatch115(SB)
+DATA ·dispatchers+928(SB)/8, $·dispatch116(SB)
+DATA ·dispatchers+936(SB)/8, $·dispatch117(SB)
+DATA ·dispatchers+944(SB)/8, $·dispatch118(SB)
+DATA ·dispatchers+952(SB)/8, $·dispatch119(SB)
+DATA ·dispatchers+960(SB)/8, $·dispatch120(SB)
+DATA ·dispatchers+968(SB)/8, $·dispatch121(SB)
+DATA ·dispatchers+976(SB)/8, $·dispatch122(SB)
+DATA ·dispatchers+984(SB)/8, $·dispatch123(SB)
+DATA ·dispatchers+992(SB)/8, $·dispatch124(SB)
+DATA ·dispatchers+1000(SB)/8, $·dispatch125(SB)
+DATA ·dispatchers+1008(SB)/8, $·dispatch126(SB)
+DATA ·dispatchers+1016(SB)/8, $·dispatch127(SB)
+DATA ·dispatchers+1024(SB)/8, $·dispatch128(SB)
+DATA ·dispatchers+1032(SB)/8, $·dispatch129(SB)
+DATA ·dispatchers+1040(SB)/8, $·dispatch130(SB)
+DATA ·dispatchers+1048(SB)/8, $·dispatch131(SB)
+DATA ·dispatchers+1056(SB)/8, $·dispatch132(SB)
+DATA ·dispatchers+1064(SB)/8, $·dispatch133(SB)
+DATA ·dispatchers+1072(SB)/8, $·dispatch134(SB)
+DATA ·dispatchers+1080(SB)/8, $·dispatch135(SB)
+DATA ·dispatchers+1088(SB)/8, $·dispatch136(SB)
+DATA ·dispatchers+1096(SB)/8, $·dispatch137(SB)
+DATA ·dispatchers+1104(SB)/8, $·dispatch138(SB)
+DATA ·dispatchers+1112(SB)/8, $·dispatch139(SB)
+DATA ·dispatchers+1120(SB)/8, $·dispatch140(SB)
+DATA ·dispatchers+1128(SB)/8, $·dispatch141(SB)
+DATA ·dispatchers+1136(SB)/8, $·dispatch142(SB)
+DATA ·dispatchers+1144(SB)/8, $·dispatch143(SB)
+DATA ·dispatchers+1152(SB)/8, $·dispatch144(SB)
+DATA ·dispatchers+1160(SB)/8, $·dispatch145(SB)
+DATA ·dispatchers+1168(SB)/8, $·dispatch146(SB)
+DATA ·dispatchers+1176(SB)/8, $·dispatch147(SB)
+DATA ·dispatchers+1184(SB)/8, $·dispatch148(SB)
+DATA ·dispatchers+1192(SB)/8, $·dispatch149(SB)
+DATA ·dispatchers+1200(SB)/8, $·dispatch150(SB)
+DATA ·dispatchers+1208(SB)/8, $·dispatch151(SB)
+DATA ·dispatchers+1216(SB)/8, $·dispatch152(SB)
+DATA ·dispatchers+1224(SB)/8, $·dispatch153(SB)
+DATA ·dispatchers+1232(SB)/8, $·dispatch154(SB)
+DATA ·dispatchers+1240(SB)/8, $·dispatch155(SB)
+DATA ·dispatchers+1248(SB)/8, $·dispatch156(SB)
+DATA ·dispatchers+1256(SB)/8, $·dispatch157(SB)
+DATA ·dispatchers+1264(SB)/8, $·dispatch158(SB)
+DATA ·dispatchers+1272(SB)/8, $·dispatch159(SB)
+DATA ·dispatchers+1280(SB)/8, $·dispatch160(SB)
+DATA ·dispatchers+1288(SB)/8, $·dispatch161(SB)
+DATA ·dispatchers+1296(SB)/8, $·dispatch162(SB)
+DATA ·dispatchers+1304(SB)/8, $·dispatch163(SB)
+DATA ·dispatchers+1312(SB)/8, $·dispatch164(SB)
+DATA ·dispatchers+1320(SB)/8, $·dispatch165(SB)
+DATA ·dispatchers+1328(SB)/8, $·dispatch166(SB)
+DATA ·dispatchers+1336(SB)/8, $·dispatch167(SB)
+DATA ·dispatchers+1344(SB)/8, $·dispatch168(SB)
+DATA ·dispatchers+1352(SB)/8, $·dispatch169(SB)
+DATA ·dispatchers+1360(SB)/8, $·dispatch170(SB)
+DATA ·dispatchers+1368(SB)/8, $·dispatch171(SB)
+DATA ·dispatchers+1376(SB)/8, $·dispatch172(SB)
+DATA ·dispatchers+1384(SB)/8, $·dispatch173(SB)
+DATA ·dispatchers+1392(SB)/8, $·dispatch174(SB)
+DATA ·dispatchers+1400(SB)/8, $·dispatch175(SB)
+DATA ·dispatchers+1408(SB)/8, $·dispatch176(SB)
+DATA ·dispatchers+1416(SB)/8, $·dispatch177(SB)
+DATA ·dispatchers+1424(SB)/8, $·dispatch178(SB)
+DATA ·dispatchers+1432(SB)/8, $·dispatch179(SB)
+DATA ·dispatchers+1440(SB)/8, $·dispatch180(SB)
+DATA ·dispatchers+1448(SB)/8, $·dispatch181(SB)
+DATA ·dispatchers+1456(SB)/8, $·dispatch182(SB)
+DATA ·dispatchers+1464(SB)/8, $·dispatch183(SB)
+DATA ·dispatchers+1472(SB)/8, $·dispatch184(SB)
+DATA ·dispatchers+1480(SB)/8, $·dispatch185(SB)
+DATA ·dispatchers+1488(SB)/8, $·dispatch186(SB)
+DATA ·dispatchers+1496(SB)/8, $·dispatch187(SB)
+DATA ·dispatchers+1504(SB)/8, $·dispatch188(SB)
+DATA ·dispatchers+1512(SB)/8, $·dispatch189(SB)
+DATA ·dispatchers+1520(SB)/8, $·dispatch190(SB)
+DATA ·dispatchers+1528(SB)/8, $·dispatch191(SB)
+DATA ·dispatchers+1536(SB)/8, $·dispatch192(SB)
+DATA ·dispatchers+1544(SB)/8, $·dispatch193(SB)
+DATA ·dispatchers+1552(SB)/8, $·dispatch194(SB)
+DATA ·dispatchers+1560(SB)/8, $·dispatch195(SB)
+DATA ·dispatchers+1568(SB)/8, $·dispatch196(SB)
+DATA ·dispatchers+1576(SB)/8, $·dispatch197(SB)
+DATA ·dispatchers+1584(SB)/8, $·dispatch198(SB)
+DATA ·dispatchers+1592(SB)/8, $·dispatch199(SB)
+DATA ·dispatchers+1600(SB)/8, $·dispatch200(SB)
+DATA ·dispatchers+1608(SB)/8, $·dispatch201(SB)
+DATA ·dispatchers+1616(SB)/8, $·dispatch202(SB)
+DATA ·dispatchers+1624(SB)/8, $·dispatch203(SB)
+DATA ·dispatchers+1632(SB)/8, $·dispatch204(SB)
+DATA ·dispatchers+1640(SB)/8, $·dispatch205(SB)
+DATA ·dispatchers+1648(SB)/8, $·dispatch206(SB)
+DATA ·dispatchers+1656(SB)/8, $·dispatch207(SB)
+DATA ·dispatchers+1664(SB)/8, $·dispatch208(SB)
+DATA ·dispatchers+1672(SB)/8, $·dispatch209(SB)
+DATA ·dispatchers+1680(SB)/8, $·dispatch210(SB)
+DATA ·dispatchers+1688(SB)/8, $·dispatch211(SB)
+DATA ·dispatchers+1696(SB)/8, $·dispatch212(SB)
+DATA ·dispatchers+1704(SB)/8, $·dispatch213(SB)
+DATA ·dispatchers+1712(SB)/8, $·dispatch214(SB)
+DATA ·dispatchers+1720(SB)/8, $·dispatch215(SB)
+DATA ·dispatchers+1728(SB)/8, $·dispatch216(SB)
+DATA ·dispatchers+1736(SB)/8, $·dispatch217(SB)
+DATA ·dispatchers+1744(SB)/8, $·dispatch218(SB)
+DATA ·dispatchers+1752(SB)/8, $·dispatch219(SB)
+DATA ·dispatchers+1760(SB)/8, $·dispatch220(SB)
+DATA ·dispatchers+1768(SB)/8, $·dispatch221(SB)
+DATA ·dispatchers+1776(SB)/8, $·dispatch222(SB)
+DATA ·dispatchers+1784(SB)/8, $·dispatch223(SB)
+DATA ·dispatchers+1792(SB)/8, $·dispatch224(SB)
+DATA ·dispatchers+1800(SB)/8, $·dispatch225(SB)
+DATA ·dispatchers+1808(SB)/8, $·dispatch226(SB)
+DATA ·dispatchers+1816(SB)/8, $·dispatch227(SB)
+DATA ·dispatchers+1824(SB)/8, $·dispatch228(SB)
+DATA ·dispatchers+1832(SB)/8, $·dispatch229(SB)
+DATA ·dispatchers+1840(SB)/8, $·dispatch230(SB)
+DATA ·dispatchers+1848(SB)/8, $·dispatch231(SB)
+DATA ·dispatchers+1856(SB)/8, $·dispatch232(SB)
+DATA ·dispatchers+1864(SB)/8, $·dispatch233(SB)
+DATA ·dispatchers+1872(SB)/8, $·dispatch234(SB)
+DATA ·dispatchers+1880(SB)/8, $·dispatch235(SB)
+DATA ·dispatchers+1888(SB)/8, $·dispatch236(SB)
+DATA ·dispatchers+1896(SB)/8, $·dispatch237(SB)
+DATA ·dispatchers+1904(SB)/8, $·dispatch238(SB)
+DATA ·dispatchers+1912(SB)/8, $·dispatch239(SB)
+DATA ·dispatchers+1920(SB)/8, $·dispatch240(SB)
+DATA ·dispatchers+1928(SB)/8, $·dispatch241(SB)
+DATA ·dispatchers+1936(SB)/8, $·dispatch242(SB)
+DATA ·dispatchers+1944(SB)/8, $·dispatch243(SB)
+DATA ·dispatchers+1952(SB)/8, $·dispatch244(SB)
+DATA ·dispatchers+1960(SB)/8, $·dispatch245(SB)
+DATA ·dispatchers+1968(SB)/8, $·dispatch246(SB)
+DATA ·dispatchers+1976(SB)/8, $·dispatch247(SB)
+DATA ·dispatchers+1984(SB)/8, $·dispatch248(SB)
+DATA ·dispatchers+1992(SB)/8, $·dispatch249(SB)
+DATA ·dispatchers+2000(SB)/8, $·dispatch250(SB)
+DATA ·dispatchers+2008(SB)/8, $·dispatch251(SB)
+DATA ·dispatchers+2016(SB)/8, $·dispatch252(SB)
+DATA ·dispatchers+2024(SB)/8, $·dispatch253(SB)
+DATA ·dispatchers+2032(SB)/8, $·dispatch254(SB)
+DATA ·dispatchers+2040(SB)/8, $·dispatch255(SB)
+GLOBL ·dispatchers(SB), RODATA, $2048
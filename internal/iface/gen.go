@@ -0,0 +1,96 @@
+//go:build ignore
+
+// gen generates the dispatchers used by the implementations of interfaces.
+// Each dispatcher loads the function at its index from the methods' table of
+// the receiver and jumps to it, leaving the arguments untouched.
+package main
+
+import (
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+// dispatchersCount is the maximum number of methods of an interface that can
+// be implemented
+const dispatchersCount = 256
+
+type arch struct {
+	Name string
+	// PtrSize is the size of a pointer, and so of an entry of the table
+	PtrSize int
+	// Dispatch is the body of the dispatcher, the format verb is replaced
+	// with the offset of the entry in the table
+	Dispatch string
+}
+
+var archs = []arch{
+	{
+		Name:    "amd64",
+		PtrSize: 8,
+		// the receiver is in AX and the closure context is passed in DX
+		Dispatch: "MOVQ 16(AX), DX\n\tMOVQ {{.Offset}}(DX), DX\n\tJMP (DX)",
+	},
+}
+
+const header = "// Code generated by gen.go; DO NOT EDIT.\n\n"
+
+var goTemplate = template.Must(template.New("go").Parse(header + `package iface
+
+// dispatchers contains the addresses of the dispatchers, the one at index i
+// calls the i-th function of the methods' table of the receiver
+var dispatchers [{{.}}]uintptr
+`))
+
+var asmTemplate = template.Must(template.New("asm").Parse(header + `#include "textflag.h"
+{{range .Dispatchers}}
+TEXT ·dispatch{{.Index}}(SB), NOSPLIT|NOFRAME, $0-0
+	{{.Body}}
+{{end}}
+{{- range .Dispatchers}}
+DATA ·dispatchers+{{.Offset}}(SB)/{{$.PtrSize}}, $·dispatch{{.Index}}(SB)
+{{- end}}
+GLOBL ·dispatchers(SB), RODATA, ${{.Size}}
+`))
+
+type dispatcher struct {
+	Index  int
+	Offset int
+	Body   string
+}
+
+func main() {
+	generate("dispatchers.go", goTemplate, dispatchersCount)
+
+	for _, a := range archs {
+		body := template.Must(template.New(a.Name).Parse(a.Dispatch))
+		dispatchers := make([]dispatcher, dispatchersCount)
+		for i := range dispatchers {
+			dispatchers[i] = dispatcher{Index: i, Offset: i * a.PtrSize}
+			var b strings.Builder
+			if err := body.Execute(&b, dispatchers[i]); err != nil {
+				log.Fatal(err)
+			}
+			dispatchers[i].Body = b.String()
+		}
+
+		generate("dispatchers_"+a.Name+".s", asmTemplate, struct {
+			PtrSize     int
+			Size        int
+			Dispatchers []dispatcher
+		}{a.PtrSize, a.PtrSize * dispatchersCount, dispatchers})
+	}
+}
+
+func generate(name string, t *template.Template, data interface{}) {
+	f, err := os.Create(name)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := t.Execute(f, data); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,47 @@
+package iface
+
+import "unsafe"
+
+// the following types mirror the layout of the runtime's type descriptors, they
+// are used to replace the methods of the implementations' types
+
+type rtype struct {
+	size       uintptr
+	ptrdata    uintptr
+	hash       uint32
+	tflag      uint8
+	align      uint8
+	fieldAlign uint8
+	kind       uint8
+	equal      func(unsafe.Pointer, unsafe.Pointer) bool
+	gcdata     *byte
+	str        int32
+	ptrToThis  int32
+}
+
+type structType struct {
+	rtype
+	pkgPath *byte
+	fields  []struct{}
+}
+
+type uncommonType struct {
+	pkgPath int32
+	mcount  uint16
+	xcount  uint16
+	moff    uint32
+	_       uint32
+}
+
+type method struct {
+	name int32
+	mtyp int32
+	ifn  int32
+	tfn  int32
+}
+
+// tflagUncommon is set if the type has methods
+const tflagUncommon = 1
+
+//go:linkname addReflectOff reflect.addReflectOff
+func addReflectOff(ptr unsafe.Pointer) int32
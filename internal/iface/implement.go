@@ -0,0 +1,51 @@
+package iface
+
+import (
+	"fmt"
+	"reflect"
+	"unsafe"
+)
+
+//go:generate go run gen.go
+
+// Implement sets the interface, pointed by target, to a new value whose
+// methods call the specified functions, one for each method in the same order
+// of reflect.Type.Method, with the types returned by MethodFuncType. The
+// methods can be called only through the interface, the functions returned by
+// reflect.Type.Method panic.
+func Implement(target reflect.Value, methods []reflect.Value) error {
+	if target.Kind() != reflect.Ptr || target.Elem().Kind() != reflect.Interface {
+		return fmt.Errorf("The target (%v) is not a pointer to an interface", target.Kind())
+	}
+
+	ifaceType := target.Type().Elem()
+	if ifaceType.NumMethod() == 0 {
+		return fmt.Errorf("The interface %v has no methods", ifaceType)
+	}
+	if ifaceType.NumMethod() != len(methods) {
+		return fmt.Errorf("The number of functions (%d) is different than the number of methods (%d)", len(methods), ifaceType.NumMethod())
+	}
+
+	table := make([]unsafe.Pointer, len(methods))
+	for i := 0; i < len(methods); i++ {
+		expected := MethodFuncType(ifaceType.Method(i).Type)
+		if methods[i].Type() != expected {
+			return fmt.Errorf("The function for the method %s has type %v, expected %v", ifaceType.Method(i).Name, methods[i].Type(), expected)
+		}
+		// a func value is a pointer to the closure that the dispatchers call
+		fn := reflect.New(expected)
+		fn.Elem().Set(methods[i])
+		table[i] = *(*unsafe.Pointer)(fn.UnsafePointer())
+	}
+
+	typ, err := implementationType(ifaceType)
+	if err != nil {
+		return err
+	}
+
+	value := reflect.New(typ).Elem()
+	*(*unsafe.Pointer)(unsafe.Add(value.Addr().UnsafePointer(), typ.Field(1).Offset)) = unsafe.Pointer(&table[0])
+	target.Elem().Set(value)
+
+	return nil
+}
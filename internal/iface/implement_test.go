@@ -0,0 +1,146 @@
+package iface
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type implementTestInterface interface {
+	Sum(a float64, b ...int) float64
+	Name() string
+}
+
+func implementTestFunctions(ifaceType reflect.Type, name string) []reflect.Value {
+	methods := make([]reflect.Value, ifaceType.NumMethod())
+	for i := 0; i < len(methods); i++ {
+		method := ifaceType.Method(i)
+		methods[i] = reflect.MakeFunc(MethodFuncType(method.Type), func(in []reflect.Value) []reflect.Value {
+			if method.Name == "Name" {
+				return []reflect.Value{reflect.ValueOf(name)}
+			}
+			sum := in[1].Float()
+			for j := 0; j < in[2].Len(); j++ {
+				sum += float64(in[2].Index(j).Int())
+			}
+			return []reflect.Value{reflect.ValueOf(sum)}
+		})
+	}
+	return methods
+}
+
+func Test_Implement(t *testing.T) {
+	var target implementTestInterface
+	ifaceType := reflect.TypeOf(&target).Elem()
+
+	err := Implement(reflect.ValueOf(&target), implementTestFunctions(ifaceType, "some-name"))
+
+	assert.Nil(t, err)
+	assert.Equal(t, "some-name", target.Name())
+	assert.Equal(t, 6.5, target.Sum(0.5, 1, 2, 3))
+}
+
+func Test_Implement_shouldPanicIfTheMethodIsCalledThroughItsType(t *testing.T) {
+	var target implementTestInterface
+	ifaceType := reflect.TypeOf(&target).Elem()
+	assert.Nil(t, Implement(reflect.ValueOf(&target), implementTestFunctions(ifaceType, "some-name")))
+	value := reflect.ValueOf(target)
+	method, _ := value.Type().MethodByName("Name")
+
+	assert.Equal(t, "some-name", value.MethodByName("Name").Call(nil)[0].Interface())
+	assert.Panics(t, func() {
+		method.Func.Call([]reflect.Value{value})
+	})
+}
+
+func Test_Implement_shouldCreateIndependentValues(t *testing.T) {
+	var first, second implementTestInterface
+	ifaceType := reflect.TypeOf(&first).Elem()
+
+	assert.Nil(t, Implement(reflect.ValueOf(&first), implementTestFunctions(ifaceType, "first")))
+	assert.Nil(t, Implement(reflect.ValueOf(&second), implementTestFunctions(ifaceType, "second")))
+
+	assert.Equal(t, "first", first.Name())
+	assert.Equal(t, "second", second.Name())
+	assert.True(t, first == first)
+	assert.False(t, first == second)
+	assert.Equal(t, 2, len(map[implementTestInterface]bool{first: true, second: true}))
+}
+
+func Test_Implement_shouldBeConvertibleToOtherInterfaces(t *testing.T) {
+	var target io.ReadCloser
+	ifaceType := reflect.TypeOf(&target).Elem()
+	closeIndex := 0
+	if ifaceType.Method(0).Name != "Close" {
+		closeIndex = 1
+	}
+	methods := make([]reflect.Value, 2)
+	methods[closeIndex] = reflect.MakeFunc(MethodFuncType(ifaceType.Method(closeIndex).Type), func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf(fmt.Errorf("some-error"))}
+	})
+	methods[1-closeIndex] = reflect.MakeFunc(MethodFuncType(ifaceType.Method(1-closeIndex).Type), func(in []reflect.Value) []reflect.Value {
+		n := copy(in[1].Bytes(), "some-content")
+		return []reflect.Value{reflect.ValueOf(n), reflect.ValueOf(io.EOF)}
+	})
+
+	err := Implement(reflect.ValueOf(&target), methods)
+
+	assert.Nil(t, err)
+	var reader io.Reader = target
+	content, err := io.ReadAll(reader)
+	assert.Nil(t, err)
+	assert.Equal(t, "some-content", string(content))
+	var closer interface{} = target
+	assert.Equal(t, "some-error", closer.(io.Closer).Close().Error())
+}
+
+func Test_Implement_shouldReturnAnErrorIfTheTargetIsNotAPointerToAnInterface(t *testing.T) {
+	var target io.Reader
+
+	assert.NotNil(t, Implement(reflect.ValueOf(target), nil))
+	assert.NotNil(t, Implement(reflect.ValueOf(&struct{}{}), nil))
+}
+
+func Test_Implement_shouldReturnAnErrorIfTheNumberOfFunctionsIsWrong(t *testing.T) {
+	var target io.Reader
+
+	err := Implement(reflect.ValueOf(&target), []reflect.Value{{}, {}})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, target)
+}
+
+func Test_Implement_shouldReturnAnErrorIfAFunctionHasTheWrongType(t *testing.T) {
+	var target io.Reader
+
+	err := Implement(reflect.ValueOf(&target), []reflect.Value{reflect.ValueOf(func() {})})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, target)
+}
+
+func Test_Implement_shouldReturnAnErrorIfTheInterfaceHasUnexportedMethods(t *testing.T) {
+	var target interface {
+		unexported()
+	}
+	ifaceType := reflect.TypeOf(&target).Elem()
+
+	err := Implement(reflect.ValueOf(&target), []reflect.Value{
+		reflect.MakeFunc(MethodFuncType(ifaceType.Method(0).Type), func(in []reflect.Value) []reflect.Value { return nil }),
+	})
+
+	assert.NotNil(t, err)
+	assert.Nil(t, target)
+}
+
+func Test_Implement_shouldReturnAnErrorIfTheInterfaceHasNoMethods(t *testing.T) {
+	var target interface{}
+
+	err := Implement(reflect.ValueOf(&target), nil)
+
+	assert.NotNil(t, err)
+	assert.Nil(t, target)
+}
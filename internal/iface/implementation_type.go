@@ -0,0 +1,81 @@
+package iface
+
+import (
+	"fmt"
+	"reflect"
+	"runtime"
+	"sync"
+	"unsafe"
+)
+
+var (
+	implementationTypesLock sync.Mutex
+	implementationTypes     = make(map[reflect.Type]reflect.Type)
+)
+
+// implementationType returns the struct type used to implement the specified
+// interface: it embeds the interface and its methods call the functions in the
+// table pointed by its second field
+func implementationType(ifaceType reflect.Type) (reflect.Type, error) {
+	implementationTypesLock.Lock()
+	defer implementationTypesLock.Unlock()
+
+	if typ, found := implementationTypes[ifaceType]; found {
+		return typ, nil
+	}
+
+	typ, err := newImplementationType(ifaceType)
+	if err != nil {
+		return nil, err
+	}
+
+	implementationTypes[ifaceType] = typ
+	return typ, nil
+}
+
+func newImplementationType(ifaceType reflect.Type) (typ reflect.Type, err error) {
+	if ifaceType.NumMethod() > len(dispatchers) {
+		return nil, fmt.Errorf("The interface %v has %d methods, at most %d are supported", ifaceType, ifaceType.NumMethod(), len(dispatchers))
+	}
+	if !supported {
+		return nil, fmt.Errorf("Interfaces can't be implemented on this architecture (%s) or Go version (%s)", runtime.GOARCH, runtime.Version())
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("Unable to implement the interface %v: %v", ifaceType, r)
+		}
+	}()
+
+	typ = reflect.StructOf([]reflect.StructField{
+		{Name: "Interface", Type: ifaceType, Anonymous: true},
+		{Name: "methods", PkgPath: receiverType.PkgPath(), Type: receiverType},
+	})
+	if typ.Field(1).Offset != 2*unsafe.Sizeof(uintptr(0)) {
+		return nil, fmt.Errorf("Unexpected layout of the type %v", typ)
+	}
+
+	// the methods that the runtime generates for the embedded interface panic,
+	// they are replaced with the dispatchers, that forward to the table; only
+	// the ones used by the interface calls are, so calling a method through
+	// reflect.Type.Method still panics
+	rt := (*[2]unsafe.Pointer)(unsafe.Pointer(&typ))[1]
+	if (*rtype)(rt).tflag&tflagUncommon == 0 {
+		return nil, fmt.Errorf("Unexpected layout of the type %v", typ)
+	}
+	ut := (*uncommonType)(unsafe.Add(rt, unsafe.Sizeof(structType{})))
+	if int(ut.mcount) != ifaceType.NumMethod() || ut.xcount != ut.mcount || uintptr(ut.moff) != unsafe.Sizeof(uncommonType{}) {
+		return nil, fmt.Errorf("Unexpected layout of the type %v", typ)
+	}
+	methods := unsafe.Slice((*method)(unsafe.Add(unsafe.Pointer(ut), ut.moff)), ut.mcount)
+	for i := range methods {
+		if typ.Method(i).Name != ifaceType.Method(i).Name || methods[i].ifn != methods[0].ifn || methods[i].tfn != methods[i].ifn {
+			return nil, fmt.Errorf("Unexpected layout of the type %v", typ)
+		}
+	}
+	for i := range methods {
+		methods[i].ifn = addReflectOff(*(*unsafe.Pointer)(unsafe.Pointer(&dispatchers[i])))
+	}
+
+	return typ, nil
+}
@@ -0,0 +1,22 @@
+package iface
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_implementationType_shouldCacheTheType(t *testing.T) {
+	ifaceType := reflect.TypeOf((*io.ReadWriteCloser)(nil)).Elem()
+
+	first, err := implementationType(ifaceType)
+	assert.Nil(t, err)
+	second, err := implementationType(ifaceType)
+	assert.Nil(t, err)
+
+	assert.Equal(t, first, second)
+	assert.True(t, first.Implements(ifaceType))
+	assert.Equal(t, reflect.Struct, first.Kind())
+}
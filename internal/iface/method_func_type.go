@@ -0,0 +1,25 @@
+package iface
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+var receiverType = reflect.TypeOf(unsafe.Pointer(nil))
+
+// MethodFuncType returns the type of the function that implements the
+// specified interface method, with the receiver as first argument
+func MethodFuncType(method reflect.Type) reflect.Type {
+	in := make([]reflect.Type, 0, method.NumIn()+1)
+	in = append(in, receiverType)
+	for i := 0; i < method.NumIn(); i++ {
+		in = append(in, method.In(i))
+	}
+
+	out := make([]reflect.Type, 0, method.NumOut())
+	for i := 0; i < method.NumOut(); i++ {
+		out = append(out, method.Out(i))
+	}
+
+	return reflect.FuncOf(in, out, method.IsVariadic())
+}
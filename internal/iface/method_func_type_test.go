@@ -0,0 +1,41 @@
+package iface
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"unsafe"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MethodFuncType(t *testing.T) {
+	tests := []struct {
+		name   string
+		method interface{}
+		want   interface{}
+	}{
+		{
+			name:   "No arguments and outputs",
+			method: func() {},
+			want:   func(unsafe.Pointer) {},
+		},
+		{
+			name:   "Arguments and outputs",
+			method: func(string, int) (bool, error) { return false, nil },
+			want:   func(unsafe.Pointer, string, int) (bool, error) { return false, nil },
+		},
+		{
+			name:   "Variadic",
+			method: fmt.Sprintf,
+			want:   func(unsafe.Pointer, string, ...interface{}) string { return "" },
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MethodFuncType(reflect.TypeOf(tt.method))
+
+			assert.Equal(t, reflect.TypeOf(tt.want), got)
+		})
+	}
+}
@@ -0,0 +1,7 @@
+//go:build amd64 && go1.21 && !go1.28
+
+package iface
+
+// supported is true if the layouts mirrored in iface.go have been verified for
+// the architecture and the Go version
+const supported = true
@@ -0,0 +1,18 @@
+package symbols
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// FuncAt returns a function of the specified type, whose code is located at
+// the specified address. The caller is responsible to make sure the type
+// matches the actual signature of the function.
+func FuncAt(addr uintptr, fnType reflect.Type) reflect.Value {
+	code := new(uintptr)
+	*code = addr
+
+	fn := reflect.New(fnType)
+	*(*unsafe.Pointer)(unsafe.Pointer(fn.Pointer())) = unsafe.Pointer(code)
+	return fn.Elem()
+}
@@ -0,0 +1,17 @@
+package symbols
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FuncAt(t *testing.T) {
+	addr := reflect.ValueOf(symbolsTestHelper).Pointer()
+
+	fn := FuncAt(addr, reflect.TypeOf(symbolsTestHelper))
+
+	assert.Equal(t, addr, fn.Pointer())
+	assert.Equal(t, 8, fn.Interface().(func(string) int)("abcd"))
+}
@@ -0,0 +1,111 @@
+package symbols
+
+import (
+	"debug/elf"
+	"debug/gosym"
+	"debug/macho"
+	"debug/pe"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"runtime"
+)
+
+// loadTable reads the function table of the current executable and computes
+// the slide between the addresses in it and the runtime ones
+func loadTable() {
+	path, err := os.Executable()
+	if err != nil {
+		tableErr = fmt.Errorf("Unable to locate the executable: %s", err.Error())
+		return
+	}
+
+	table, tableErr = readTable(path)
+	if tableErr != nil {
+		return
+	}
+
+	pc := reflect.ValueOf(loadTable).Pointer()
+	fn := table.LookupFunc(runtime.FuncForPC(pc).Name())
+	if fn == nil {
+		table, tableErr = nil, errors.New("Unable to find a known function in the executable's function table")
+		return
+	}
+	slide = pc - uintptr(fn.Entry)
+}
+
+// readTable parses the function table of the specified executable
+func readTable(path string) (*gosym.Table, error) {
+	data, textStart, err := readPclntab(path)
+	if err != nil {
+		return nil, err
+	}
+	return gosym.NewTable(nil, gosym.NewLineTable(data, textStart))
+}
+
+// readPclntab returns the content of the function table, and the start
+// address of the text segment, of the specified executable
+func readPclntab(path string) ([]byte, uint64, error) {
+	if f, err := elf.Open(path); err == nil {
+		defer f.Close()
+		text, table := f.Section(".text"), f.Section(".gopclntab")
+		if text == nil || table == nil {
+			return nil, 0, errors.New("The executable does not contain the function table, it might have been stripped")
+		}
+		data, err := table.Data()
+		return data, text.Addr, err
+	}
+
+	if f, err := macho.Open(path); err == nil {
+		defer f.Close()
+		text, table := f.Section("__text"), f.Section("__gopclntab")
+		if text == nil || table == nil {
+			return nil, 0, errors.New("The executable does not contain the function table, it might have been stripped")
+		}
+		data, err := table.Data()
+		return data, text.Addr, err
+	}
+
+	if f, err := pe.Open(path); err == nil {
+		defer f.Close()
+		return readPEPclntab(f)
+	}
+
+	return nil, 0, errors.New("Unsupported executable format")
+}
+
+// readPEPclntab returns the content of the function table, and the start
+// address of the text segment, of the specified PE file
+func readPEPclntab(f *pe.File) ([]byte, uint64, error) {
+	var imageBase uint64
+	switch header := f.OptionalHeader.(type) {
+	case *pe.OptionalHeader32:
+		imageBase = uint64(header.ImageBase)
+	case *pe.OptionalHeader64:
+		imageBase = header.ImageBase
+	}
+
+	text := f.Section(".text")
+	start, end := findPESymbol(f, "runtime.pclntab"), findPESymbol(f, "runtime.epclntab")
+	if text == nil || start == nil || end == nil || start.SectionNumber != end.SectionNumber || start.SectionNumber < 1 {
+		return nil, 0, errors.New("The executable does not contain the function table, it might have been stripped")
+	}
+
+	data, err := f.Sections[start.SectionNumber-1].Data()
+	if err != nil {
+		return nil, 0, err
+	}
+	return data[start.Value:end.Value], imageBase + uint64(text.VirtualAddress), nil
+}
+
+// findPESymbol returns the symbol with the specified name, or nil if not
+// found
+func findPESymbol(f *pe.File, name string) *pe.Symbol {
+	for _, symbol := range f.Symbols {
+		if symbol.Name == name {
+			return symbol
+		}
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package symbols
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_readTable(t *testing.T) {
+	path, err := os.Executable()
+	assert.Nil(t, err)
+
+	table, err := readTable(path)
+
+	assert.Nil(t, err)
+	assert.NotNil(t, table.LookupFunc("github.com/pasdam/mockit/internal/symbols.readTable"))
+}
+
+func Test_readTable_shouldReturnAnErrorIfTheFileIsNotAnExecutable(t *testing.T) {
+	table, err := readTable("load_table.go")
+
+	assert.Nil(t, table)
+	assert.NotNil(t, err)
+}
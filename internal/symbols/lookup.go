@@ -0,0 +1,28 @@
+package symbols
+
+import (
+	"fmt"
+	"runtime"
+)
+
+// Lookup returns the runtime address of the function with the specified
+// fully qualified name, i.e. "github.com/user/pkg.helper" or
+// "github.com/user/pkg.(*Type).method"
+func Lookup(name string) (uintptr, error) {
+	tableOnce.Do(loadTable)
+	if tableErr != nil {
+		return 0, tableErr
+	}
+
+	fn := table.LookupFunc(name)
+	if fn == nil {
+		return 0, fmt.Errorf("The symbol %s was not found in the executable: it might have been removed by the linker as dead code, or inlined in all its callers (in this case try to disable inlining with -gcflags=-l)", name)
+	}
+
+	addr := uintptr(fn.Entry) + slide
+	if runtimeFn := runtime.FuncForPC(addr); runtimeFn == nil || runtimeFn.Entry() != addr || runtimeFn.Name() != name {
+		return 0, fmt.Errorf("The symbol %s was found in the executable, but it doesn't match the running code", name)
+	}
+
+	return addr, nil
+}
@@ -0,0 +1,32 @@
+package symbols
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// LookupMethod returns the receiver type and the runtime address of the
+// specified method of the type. For pointer types, methods with value
+// receiver are looked up first, as the pointer ones might just be wrappers
+// generated by the compiler.
+func LookupMethod(instanceType reflect.Type, method string) (reflect.Type, uintptr, error) {
+	var receivers []reflect.Type
+	if instanceType.Kind() == reflect.Ptr {
+		receivers = append(receivers, instanceType.Elem())
+	}
+	receivers = append(receivers, instanceType)
+
+	err := fmt.Errorf("The type %v is not a named type, it can't have methods", instanceType)
+	for _, receiver := range receivers {
+		if receiver.Name() == "" && (receiver.Kind() != reflect.Ptr || receiver.Elem().Name() == "") {
+			continue
+		}
+
+		var addr uintptr
+		addr, err = Lookup(MethodSymbol(receiver, method))
+		if err == nil {
+			return receiver, addr, nil
+		}
+	}
+	return nil, 0, err
+}
@@ -0,0 +1,69 @@
+package symbols
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_LookupMethod(t *testing.T) {
+	instance := &symbolsTestType{value: 3}
+	assert.Equal(t, 5, instance.pointerMethod(2))
+	assert.Equal(t, 6, instance.valueMethod(2))
+
+	tests := []struct {
+		name         string
+		instance     interface{}
+		method       string
+		wantReceiver reflect.Type
+		wantAddr     uintptr
+	}{
+		{
+			name:         "Pointer receiver",
+			instance:     instance,
+			method:       "pointerMethod",
+			wantReceiver: reflect.TypeOf(instance),
+			wantAddr:     reflect.ValueOf((*symbolsTestType).pointerMethod).Pointer(),
+		},
+		{
+			name:         "Value receiver from pointer",
+			instance:     instance,
+			method:       "valueMethod",
+			wantReceiver: reflect.TypeOf(*instance),
+			wantAddr:     reflect.ValueOf(symbolsTestType.valueMethod).Pointer(),
+		},
+		{
+			name:         "Value receiver",
+			instance:     *instance,
+			method:       "valueMethod",
+			wantReceiver: reflect.TypeOf(*instance),
+			wantAddr:     reflect.ValueOf(symbolsTestType.valueMethod).Pointer(),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			receiver, addr, err := LookupMethod(reflect.TypeOf(tt.instance), tt.method)
+
+			assert.Nil(t, err)
+			assert.Equal(t, tt.wantReceiver, receiver)
+			assert.Equal(t, tt.wantAddr, addr)
+		})
+	}
+}
+
+func Test_LookupMethod_shouldReturnAnErrorIfTheMethodDoesNotExist(t *testing.T) {
+	receiver, addr, err := LookupMethod(reflect.TypeOf(&symbolsTestType{}), "notExistingMethod")
+
+	assert.Nil(t, receiver)
+	assert.Equal(t, uintptr(0), addr)
+	assert.NotNil(t, err)
+}
+
+func Test_LookupMethod_shouldReturnAnErrorIfTheTypeIsNotNamed(t *testing.T) {
+	receiver, addr, err := LookupMethod(reflect.TypeOf(struct{}{}), "someMethod")
+
+	assert.Nil(t, receiver)
+	assert.Equal(t, uintptr(0), addr)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,25 @@
+package symbols
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Lookup(t *testing.T) {
+	assert.Equal(t, 6, symbolsTestHelper("abc"))
+
+	addr, err := Lookup("github.com/pasdam/mockit/internal/symbols.symbolsTestHelper")
+
+	assert.Nil(t, err)
+	assert.Equal(t, reflect.ValueOf(symbolsTestHelper).Pointer(), addr)
+}
+
+func Test_Lookup_shouldReturnAnErrorIfTheSymbolDoesNotExist(t *testing.T) {
+	addr, err := Lookup("github.com/pasdam/mockit/internal/symbols.notExistingFunction")
+
+	assert.Equal(t, uintptr(0), addr)
+	assert.NotNil(t, err)
+	assert.Contains(t, err.Error(), "-gcflags=-l")
+}
@@ -0,0 +1,25 @@
+package symbols
+
+import (
+	"reflect"
+	"strings"
+)
+
+// MethodSymbol returns the symbol name of the method of the specified
+// receiver type, i.e. "github.com/user/pkg.(*Type).method" for a pointer
+// receiver
+func MethodSymbol(receiver reflect.Type, method string) string {
+	if receiver.Kind() == reflect.Ptr {
+		elem := receiver.Elem()
+		return escapePkgPath(elem.PkgPath()) + ".(*" + elem.Name() + ")." + method
+	}
+	return escapePkgPath(receiver.PkgPath()) + "." + receiver.Name() + "." + method
+}
+
+// escapePkgPath escapes the dots in the last element of the package path, in
+// the same way the linker does, i.e. "gopkg.in/yaml.v2" becomes
+// "gopkg.in/yaml%2ev2"
+func escapePkgPath(path string) string {
+	i := strings.LastIndex(path, "/")
+	return path[:i+1] + strings.Replace(path[i+1:], ".", "%2e", -1)
+}
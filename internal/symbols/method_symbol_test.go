@@ -0,0 +1,38 @@
+package symbols
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_MethodSymbol(t *testing.T) {
+	tests := []struct {
+		name     string
+		receiver reflect.Type
+		want     string
+	}{
+		{
+			name:     "Pointer receiver",
+			receiver: reflect.TypeOf(&symbolsTestType{}),
+			want:     "github.com/pasdam/mockit/internal/symbols.(*symbolsTestType).someMethod",
+		},
+		{
+			name:     "Value receiver",
+			receiver: reflect.TypeOf(symbolsTestType{}),
+			want:     "github.com/pasdam/mockit/internal/symbols.symbolsTestType.someMethod",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MethodSymbol(tt.receiver, "someMethod"))
+		})
+	}
+}
+
+func Test_escapePkgPath(t *testing.T) {
+	assert.Equal(t, "gopkg.in/yaml%2ev2", escapePkgPath("gopkg.in/yaml.v2"))
+	assert.Equal(t, "github.com/pasdam/mockit", escapePkgPath("github.com/pasdam/mockit"))
+	assert.Equal(t, "main", escapePkgPath("main"))
+}
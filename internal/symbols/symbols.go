@@ -0,0 +1,18 @@
+// Package symbols resolves functions by name, through the function table
+// embedded by the linker in the executable
+package symbols
+
+import (
+	"debug/gosym"
+	"sync"
+)
+
+var (
+	table     *gosym.Table
+	tableErr  error
+	tableOnce sync.Once
+
+	// slide is the offset between the address of a function at runtime and
+	// its entry in the table (i.e. for position independent executables)
+	slide uintptr
+)
@@ -0,0 +1,20 @@
+package symbols
+
+//go:noinline
+func symbolsTestHelper(s string) int {
+	return len(s) * 2
+}
+
+type symbolsTestType struct {
+	value int
+}
+
+//go:noinline
+func (s *symbolsTestType) pointerMethod(n int) int {
+	return s.value + n
+}
+
+//go:noinline
+func (s symbolsTestType) valueMethod(n int) int {
+	return s.value * n
+}
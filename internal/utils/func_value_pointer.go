@@ -0,0 +1,13 @@
+package utils
+
+import (
+	"reflect"
+	"unsafe"
+)
+
+// FuncValuePointer returns the pointer to the function value, that includes
+// the closure context
+func FuncValuePointer(fn reflect.Value) unsafe.Pointer {
+	i := fn.Interface()
+	return (*[2]unsafe.Pointer)(unsafe.Pointer(&i))[1]
+}
@@ -0,0 +1,22 @@
+package utils_test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/pasdam/mockit/internal/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_FuncValuePointer(t *testing.T) {
+	closure := func(s string) func() string {
+		return func() string { return s }
+	}
+	a, b := closure("a"), closure("b")
+
+	assert.Equal(t, utils.FuncValuePointer(reflect.ValueOf(strings.ToLower)), utils.FuncValuePointer(reflect.ValueOf(strings.ToLower)))
+	assert.NotEqual(t, utils.FuncValuePointer(reflect.ValueOf(strings.ToLower)), utils.FuncValuePointer(reflect.ValueOf(strings.ToUpper)))
+	assert.Equal(t, utils.FuncValuePointer(reflect.ValueOf(a)), utils.FuncValuePointer(reflect.ValueOf(a)))
+	assert.NotEqual(t, utils.FuncValuePointer(reflect.ValueOf(a)), utils.FuncValuePointer(reflect.ValueOf(b)))
+}
@@ -0,0 +1,28 @@
+package argument
+
+// AllOf returns a matcher for the arguments that satisfy all the specified
+// matchers (values are matched by equality)
+func AllOf(matchers ...interface{}) SelfDescribingMatcher {
+	return allOf("AllOf", matchers)
+}
+
+func allOf(name string, matchers []interface{}) SelfDescribingMatcher {
+	ms := make([]Matcher, 0, len(matchers))
+	for _, matcher := range matchers {
+		ms = append(ms, toMatcher(matcher))
+	}
+
+	return &describedMatcher{
+		name:     name,
+		values:   matchers,
+		describe: func() string { return name + "(" + describeValues(matchers) + ")" },
+		matcher: func(arg interface{}) bool {
+			for _, m := range ms {
+				if !m(arg) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
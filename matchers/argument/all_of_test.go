@@ -0,0 +1,31 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAllOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []interface{}
+		arg      interface{}
+		want     bool
+	}{
+		{name: "Should match if there are no matchers", matchers: nil, arg: 1, want: true},
+		{name: "Should match if all the matchers match", matchers: []interface{}{NotZero, IsA[int](), 1}, arg: 1, want: true},
+		{name: "Should not match if a matcher doesn't match", matchers: []interface{}{NotZero, IsA[int](), 2}, arg: 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AllOf(tt.matchers...).Matches(tt.arg))
+			assert.Equal(t, tt.want, And(tt.matchers...).Matches(tt.arg))
+		})
+	}
+}
+
+func TestAllOf_String(t *testing.T) {
+	assert.Equal(t, "AllOf(argument.NotNil, IsA(string))", AllOf(NotNil, IsA[string]()).String())
+	assert.Equal(t, "And(1, 2)", And(1, 2).String())
+}
@@ -0,0 +1,6 @@
+package argument
+
+// And is an alias of AllOf
+func And(matchers ...interface{}) SelfDescribingMatcher {
+	return allOf("And", matchers)
+}
@@ -0,0 +1,31 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnyOf(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []interface{}
+		arg      interface{}
+		want     bool
+	}{
+		{name: "Should not match if there are no matchers", matchers: nil, arg: 1, want: false},
+		{name: "Should match if a matcher matches", matchers: []interface{}{Nil, IsA[string](), 1}, arg: 1, want: true},
+		{name: "Should not match if no matcher matches", matchers: []interface{}{Nil, IsA[string](), 2}, arg: 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, AnyOf(tt.matchers...).Matches(tt.arg))
+			assert.Equal(t, tt.want, Or(tt.matchers...).Matches(tt.arg))
+		})
+	}
+}
+
+func TestAnyOf_String(t *testing.T) {
+	assert.Equal(t, `AnyOf("a", "b")`, AnyOf("a", "b").String())
+	assert.Equal(t, `Or(Not("a"), nil)`, Or(Not("a"), nil).String())
+}
@@ -0,0 +1,7 @@
+package argument
+
+// AnyRemaining matches any number (including zero) of remaining variadic
+// arguments
+func AnyRemaining(args []interface{}) bool {
+	return true
+}
@@ -0,0 +1,34 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAnyRemaining(t *testing.T) {
+	tests := []struct {
+		name string
+		args []interface{}
+	}{
+		{
+			name: "Should match no arguments",
+			args: nil,
+		},
+		{
+			name: "Should match a single argument",
+			args: []interface{}{"some string"},
+		},
+		{
+			name: "Should match multiple arguments",
+			args: []interface{}{"some string", 123456, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := AnyRemaining(tt.args)
+
+			assert.True(t, got)
+		})
+	}
+}
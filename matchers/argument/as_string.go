@@ -0,0 +1,26 @@
+package argument
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// asString returns the string value of the argument, if it is a string, a
+// []byte or a fmt.Stringer
+func asString(arg interface{}) (string, bool) {
+	if Nil(arg) {
+		return "", false
+	}
+
+	value := reflect.ValueOf(arg)
+	if value.Kind() == reflect.String {
+		return value.String(), true
+	}
+	if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+		return string(value.Bytes()), true
+	}
+	if stringer, ok := arg.(fmt.Stringer); ok {
+		return stringer.String(), true
+	}
+	return "", false
+}
@@ -0,0 +1,37 @@
+package argument
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_asString(t *testing.T) {
+	type namedString string
+	var nilURL *url.URL
+	tests := []struct {
+		name   string
+		arg    interface{}
+		want   string
+		wantOk bool
+	}{
+		{name: "Should return strings", arg: "some-value", want: "some-value", wantOk: true},
+		{name: "Should return named strings", arg: namedString("some-value"), want: "some-value", wantOk: true},
+		{name: "Should convert byte slices", arg: []byte("some-value"), want: "some-value", wantOk: true},
+		{name: "Should convert stringers", arg: time.Second, want: "1s", wantOk: true},
+		{name: "Should convert stringer pointers", arg: &url.URL{Scheme: "http", Host: "host"}, want: "http://host", wantOk: true},
+		{name: "Should not convert nil", arg: nil, want: "", wantOk: false},
+		{name: "Should not convert nil stringers", arg: nilURL, want: "", wantOk: false},
+		{name: "Should not convert other types", arg: 1, want: "", wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := asString(tt.arg)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
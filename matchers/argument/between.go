@@ -0,0 +1,16 @@
+package argument
+
+// Between returns a matcher for the arguments in the range [min, max], the
+// bounds can be numbers of any kind (including time.Duration) or time.Time
+func Between(min interface{}, max interface{}) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     "Between",
+		values:   []interface{}{min, max},
+		describe: func() string { return "Between(" + describeValues([]interface{}{min, max}) + ")" },
+		matcher: func(arg interface{}) bool {
+			cmpMin, okMin := compare(arg, min)
+			cmpMax, okMax := compare(arg, max)
+			return okMin && okMax && cmpMin >= 0 && cmpMax <= 0
+		},
+	}
+}
@@ -0,0 +1,22 @@
+package argument
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBetween(t *testing.T) {
+	now := time.Now()
+
+	assert.True(t, Between(1, 3).Matches(1))
+	assert.True(t, Between(1, 3).Matches(uint8(2)))
+	assert.True(t, Between(1, 3).Matches(3.0))
+	assert.False(t, Between(1, 3).Matches(3.5))
+	assert.False(t, Between(1, 3).Matches(0))
+	assert.True(t, Between(now, now.Add(time.Hour)).Matches(now.Add(time.Minute)))
+	assert.False(t, Between(now, now.Add(time.Hour)).Matches(now.Add(-time.Minute)))
+	assert.False(t, Between(1, 3).Matches("2"))
+	assert.Equal(t, "Between(1, 3)", Between(1, 3).String())
+}
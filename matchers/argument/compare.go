@@ -0,0 +1,72 @@
+package argument
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// compare returns -1, 0 or +1 if a is less, equal or greater than b, the
+// values must be both numbers (of any kind, including time.Duration) or both
+// time.Time, otherwise it returns false
+func compare(a interface{}, b interface{}) (int, bool) {
+	if timeA, ok := a.(time.Time); ok {
+		timeB, ok := b.(time.Time)
+		if !ok {
+			return 0, false
+		}
+		return compareTimes(timeA, timeB), true
+	}
+
+	valueA, valueB := reflect.ValueOf(a), reflect.ValueOf(b)
+	kindA, kindB := numericKindOf(valueA), numericKindOf(valueB)
+	switch {
+	case kindA == notNumeric || kindB == notNumeric:
+		return 0, false
+
+	case kindA == floatKind || kindB == floatKind:
+		floatA, floatB := toFloat(valueA), toFloat(valueB)
+		if math.IsNaN(floatA) || math.IsNaN(floatB) {
+			return 0, false
+		}
+		return compareOrdered(floatA, floatB), true
+
+	case kindA == signedKind && kindB == signedKind:
+		return compareOrdered(valueA.Int(), valueB.Int()), true
+
+	case kindA == unsignedKind && kindB == unsignedKind:
+		return compareOrdered(valueA.Uint(), valueB.Uint()), true
+
+	case kindA == signedKind:
+		if valueA.Int() < 0 {
+			return -1, true
+		}
+		return compareOrdered(uint64(valueA.Int()), valueB.Uint()), true
+
+	default:
+		if valueB.Int() < 0 {
+			return 1, true
+		}
+		return compareOrdered(valueA.Uint(), uint64(valueB.Int())), true
+	}
+}
+
+func compareOrdered[T int64 | uint64 | float64](a T, b T) int {
+	if a < b {
+		return -1
+	}
+	if a > b {
+		return 1
+	}
+	return 0
+}
+
+func compareTimes(a time.Time, b time.Time) int {
+	if a.Before(b) {
+		return -1
+	}
+	if a.After(b) {
+		return 1
+	}
+	return 0
+}
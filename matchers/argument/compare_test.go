@@ -0,0 +1,46 @@
+package argument
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_compare(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		a      interface{}
+		b      interface{}
+		want   int
+		wantOk bool
+	}{
+		{name: "Should compare ints", a: 1, b: 2, want: -1, wantOk: true},
+		{name: "Should compare ints of different sizes", a: int8(3), b: int64(2), want: 1, wantOk: true},
+		{name: "Should compare uints", a: uint(2), b: uint16(2), want: 0, wantOk: true},
+		{name: "Should compare negative ints with uints", a: -1, b: uint64(math.MaxUint64), want: -1, wantOk: true},
+		{name: "Should compare ints with uints", a: 3, b: uint(2), want: 1, wantOk: true},
+		{name: "Should compare uints with negative ints", a: uint(0), b: -1, want: 1, wantOk: true},
+		{name: "Should compare large uints with ints", a: uint64(math.MaxUint64), b: math.MaxInt64, want: 1, wantOk: true},
+		{name: "Should compare floats", a: 1.5, b: float32(2.5), want: -1, wantOk: true},
+		{name: "Should compare floats with ints", a: 2.5, b: 2, want: 1, wantOk: true},
+		{name: "Should compare durations", a: time.Second, b: time.Minute, want: -1, wantOk: true},
+		{name: "Should compare durations with ints", a: time.Second, b: int64(time.Second), want: 0, wantOk: true},
+		{name: "Should compare times", a: now, b: now.Add(-time.Second), want: 1, wantOk: true},
+		{name: "Should not compare times with other types", a: now, b: 1, want: 0, wantOk: false},
+		{name: "Should not compare other types with times", a: 1, b: now, want: 0, wantOk: false},
+		{name: "Should not compare strings", a: "a", b: "b", want: 0, wantOk: false},
+		{name: "Should not compare nil", a: nil, b: 1, want: 0, wantOk: false},
+		{name: "Should not compare NaN", a: math.NaN(), b: 1, want: 0, wantOk: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, gotOk := compare(tt.a, tt.b)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantOk, gotOk)
+		})
+	}
+}
@@ -0,0 +1,11 @@
+package argument
+
+import "strings"
+
+// Contains returns a matcher for the string arguments (including []byte and
+// fmt.Stringer) that contain the substring
+func Contains(substr string) SelfDescribingMatcher {
+	return stringMatcher("Contains", substr, func(s string) bool {
+		return strings.Contains(s, substr)
+	})
+}
@@ -0,0 +1,24 @@
+package argument
+
+// ContainsElement returns a matcher for the slices, arrays or maps (values)
+// with at least an element that satisfies the matcher, or equal to the value
+func ContainsElement(element interface{}) SelfDescribingMatcher {
+	m := toMatcher(element)
+	return &describedMatcher{
+		name:     "ContainsElement",
+		values:   []interface{}{element},
+		describe: func() string { return "ContainsElement(" + describeValue(element) + ")" },
+		matcher: func(arg interface{}) bool {
+			elems, ok := elements(arg)
+			if !ok {
+				return false
+			}
+			for _, elem := range elems {
+				if m(elem) {
+					return true
+				}
+			}
+			return false
+		},
+	}
+}
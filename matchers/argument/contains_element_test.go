@@ -0,0 +1,18 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestContainsElement(t *testing.T) {
+	assert.True(t, ContainsElement(2).Matches([]int{1, 2}))
+	assert.True(t, ContainsElement(Gt(1)).Matches([]int{1, 2}))
+	assert.True(t, ContainsElement("a").Matches(map[int]string{1: "a"}))
+	assert.True(t, ContainsElement(nil).Matches([]*int{nil}))
+	assert.False(t, ContainsElement(3).Matches([]int{1, 2}))
+	assert.False(t, ContainsElement(1).Matches([]int{}))
+	assert.False(t, ContainsElement("a").Matches("abc"))
+	assert.Equal(t, "ContainsElement(Gt(1))", ContainsElement(Gt(1)).String())
+}
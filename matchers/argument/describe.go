@@ -0,0 +1,12 @@
+package argument
+
+// Describe returns a SelfDescribingMatcher with the specified description, that
+// uses the matcher to match the arguments
+func Describe(description string, matcher Matcher) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     "Describe",
+		values:   []interface{}{description, matcher},
+		describe: func() string { return description },
+		matcher:  matcher,
+	}
+}
@@ -0,0 +1,17 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDescribe(t *testing.T) {
+	m := Describe("some-description", func(arg interface{}) bool {
+		return arg == "some-value"
+	})
+
+	assert.Equal(t, "some-description", m.String())
+	assert.True(t, m.Matches("some-value"))
+	assert.False(t, m.Matches("some-other-value"))
+}
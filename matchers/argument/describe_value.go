@@ -0,0 +1,27 @@
+package argument
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/pasdam/mockit/internal/format"
+)
+
+// describeValue returns the description of a matcher or of a value
+func describeValue(value interface{}) string {
+	switch v := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return string(strconv.AppendQuote(nil, v))
+	case fmt.Stringer:
+		return v.String()
+	}
+
+	valueOf := reflect.ValueOf(value)
+	if valueOf.Kind() == reflect.Func && !valueOf.IsNil() {
+		return format.FuncName(valueOf)
+	}
+	return fmt.Sprint(value)
+}
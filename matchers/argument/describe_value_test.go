@@ -0,0 +1,28 @@
+package argument
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_describeValue(t *testing.T) {
+	tests := []struct {
+		name  string
+		value interface{}
+		want  string
+	}{
+		{name: "Should describe nil", value: nil, want: "nil"},
+		{name: "Should quote strings", value: "some-value", want: `"some-value"`},
+		{name: "Should describe stringers", value: time.Second, want: "1s"},
+		{name: "Should describe self-describing matchers", value: Eq(1), want: "Eq(1)"},
+		{name: "Should describe functions by name", value: Any, want: "argument.Any"},
+		{name: "Should describe other values", value: []int{1, 2}, want: "[1 2]"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, describeValue(tt.value))
+		})
+	}
+}
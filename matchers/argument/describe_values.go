@@ -0,0 +1,12 @@
+package argument
+
+import "strings"
+
+// describeValues returns the descriptions of the values, separated by comma
+func describeValues(values []interface{}) string {
+	descriptions := make([]string, 0, len(values))
+	for _, value := range values {
+		descriptions = append(descriptions, describeValue(value))
+	}
+	return strings.Join(descriptions, ", ")
+}
@@ -0,0 +1,12 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_describeValues(t *testing.T) {
+	assert.Equal(t, "", describeValues(nil))
+	assert.Equal(t, `"a", 1, argument.Nil`, describeValues([]interface{}{"a", 1, Nil}))
+}
@@ -0,0 +1,35 @@
+package argument
+
+// describedMatcher is a SelfDescribingMatcher built on a Matcher, the
+// description is computed only when needed, as it might use functions that are
+// mocked; name and values identify the matcher, as closures can't be compared
+type describedMatcher struct {
+	describe func() string
+	matcher  Matcher
+	name     string
+	values   []interface{}
+}
+
+func (m *describedMatcher) Matches(arg interface{}) bool {
+	return m.matcher(arg)
+}
+
+func (m *describedMatcher) String() string {
+	return m.describe()
+}
+
+// Identical returns true if the other value is a matcher built with the same
+// name and identical values, so it matches the same arguments
+func (m *describedMatcher) Identical(other interface{}) bool {
+	o, ok := other.(*describedMatcher)
+	if !ok || o.name != m.name || len(o.values) != len(m.values) {
+		return false
+	}
+
+	for i := range m.values {
+		if !identicalValues(m.values[i], o.values[i]) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,31 @@
+package argument
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_describedMatcher_Identical(t *testing.T) {
+	tests := []struct {
+		name string
+		a    SelfDescribingMatcher
+		b    interface{}
+		want bool
+	}{
+		{name: "Should be identical if name and values are equal", a: HasPrefix("a"), b: HasPrefix("a"), want: true},
+		{name: "Should be identical if the nested matchers are identical", a: Not(AllOf(Gt(1), Nil)), b: Not(AllOf(Gt(1), Nil)), want: true},
+		{name: "Should be identical if the types are the same", a: IsA[string](), b: IsType(reflect.TypeOf("")), want: true},
+		{name: "Should not be identical if a value is different", a: HasPrefix("a"), b: HasPrefix("b"), want: false},
+		{name: "Should not be identical if the name is different", a: HasPrefix("a"), b: HasSuffix("a"), want: false},
+		{name: "Should not be identical if a nested matcher is different", a: Not(AllOf(Gt(1), Nil)), b: Not(AllOf(Gt(2), Nil)), want: false},
+		{name: "Should not be identical if the count of values is different", a: AnyOf(1, 2), b: AnyOf(1), want: false},
+		{name: "Should not be identical to other values", a: Eq("a"), b: "a", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.a.(*describedMatcher).Identical(tt.b))
+		})
+	}
+}
@@ -0,0 +1,25 @@
+package argument
+
+// Each returns a matcher for the slices, arrays or maps (values) whose
+// elements all satisfy the matcher, or are equal to the value; empty
+// collections always match
+func Each(matcher interface{}) SelfDescribingMatcher {
+	m := toMatcher(matcher)
+	return &describedMatcher{
+		name:     "Each",
+		values:   []interface{}{matcher},
+		describe: func() string { return "Each(" + describeValue(matcher) + ")" },
+		matcher: func(arg interface{}) bool {
+			elems, ok := elements(arg)
+			if !ok {
+				return false
+			}
+			for _, elem := range elems {
+				if !m(elem) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
@@ -0,0 +1,16 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEach(t *testing.T) {
+	assert.True(t, Each(Gt(0)).Matches([]int{1, 2}))
+	assert.True(t, Each(NotNil).Matches(map[string]*int{"a": new(int)}))
+	assert.True(t, Each(1).Matches([]int{}))
+	assert.False(t, Each(Gt(1)).Matches([]int{1, 2}))
+	assert.False(t, Each(Any).Matches(1))
+	assert.Equal(t, "Each(argument.NotNil)", Each(NotNil).String())
+}
@@ -0,0 +1,26 @@
+package argument
+
+import "reflect"
+
+// elements returns the elements of the argument if it is a slice or an array,
+// or its values if it is a map
+func elements(arg interface{}) ([]interface{}, bool) {
+	value := reflect.ValueOf(arg)
+	switch value.Kind() {
+	case reflect.Slice, reflect.Array:
+		result := make([]interface{}, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			result = append(result, value.Index(i).Interface())
+		}
+		return result, true
+
+	case reflect.Map:
+		result := make([]interface{}, 0, value.Len())
+		iter := value.MapRange()
+		for iter.Next() {
+			result = append(result, iter.Value().Interface())
+		}
+		return result, true
+	}
+	return nil, false
+}
@@ -0,0 +1,52 @@
+package argument
+
+// ElementsMatch returns a matcher for the slices, arrays or maps (values) whose
+// elements satisfy the specified matchers (or are equal to the values), in any
+// order; each element must satisfy a different matcher
+func ElementsMatch(matchers ...interface{}) SelfDescribingMatcher {
+	ms := make([]Matcher, 0, len(matchers))
+	for _, matcher := range matchers {
+		ms = append(ms, toMatcher(matcher))
+	}
+
+	return &describedMatcher{
+		name:     "ElementsMatch",
+		values:   matchers,
+		describe: func() string { return "ElementsMatch(" + describeValues(matchers) + ")" },
+		matcher: func(arg interface{}) bool {
+			elems, ok := elements(arg)
+			if !ok || len(elems) != len(ms) {
+				return false
+			}
+
+			// bipartite matching between elements and matchers, as an element
+			// might satisfy more than one matcher
+			matchedBy := make([]int, len(elems))
+			for i := range matchedBy {
+				matchedBy[i] = -1
+			}
+			for m := range ms {
+				if !assignElement(m, ms, elems, matchedBy, make([]bool, len(elems))) {
+					return false
+				}
+			}
+			return true
+		},
+	}
+}
+
+// assignElement looks for an element for the matcher at index m, moving the
+// elements already assigned to other matchers if needed
+func assignElement(m int, ms []Matcher, elems []interface{}, matchedBy []int, visited []bool) bool {
+	for i, elem := range elems {
+		if visited[i] || !ms[m](elem) {
+			continue
+		}
+		visited[i] = true
+		if matchedBy[i] < 0 || assignElement(matchedBy[i], ms, elems, matchedBy, visited) {
+			matchedBy[i] = m
+			return true
+		}
+	}
+	return false
+}
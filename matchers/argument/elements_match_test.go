@@ -0,0 +1,35 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestElementsMatch(t *testing.T) {
+	tests := []struct {
+		name     string
+		matchers []interface{}
+		arg      interface{}
+		want     bool
+	}{
+		{name: "Should match the same elements in any order", matchers: []interface{}{3, 1, 2}, arg: []int{1, 2, 3}, want: true},
+		{name: "Should match duplicated elements", matchers: []interface{}{1, 1, 2}, arg: []int{1, 2, 1}, want: true},
+		{name: "Should match elements satisfying the matchers", matchers: []interface{}{Gt(0), 1}, arg: []int{1, 2}, want: true},
+		{name: "Should match the values of maps", matchers: []interface{}{"b", "a"}, arg: map[int]string{1: "a", 2: "b"}, want: true},
+		{name: "Should match empty collections", matchers: nil, arg: []int{}, want: true},
+		{name: "Should not match if the count is different", matchers: []interface{}{1, 2}, arg: []int{1, 2, 2}, want: false},
+		{name: "Should not match if an element is different", matchers: []interface{}{1, 3}, arg: []int{1, 2}, want: false},
+		{name: "Should not match if the duplicates are different", matchers: []interface{}{1, 1, 2}, arg: []int{1, 2, 2}, want: false},
+		{name: "Should not match other types", matchers: []interface{}{"a"}, arg: "a", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ElementsMatch(tt.matchers...).Matches(tt.arg))
+		})
+	}
+}
+
+func TestElementsMatch_String(t *testing.T) {
+	assert.Equal(t, `ElementsMatch(1, "a", Gt(2))`, ElementsMatch(1, "a", Gt(2)).String())
+}
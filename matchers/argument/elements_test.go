@@ -0,0 +1,30 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_elements(t *testing.T) {
+	got, ok := elements([]int{1, 2})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{1, 2}, got)
+
+	got, ok = elements([2]string{"a", "b"})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"a", "b"}, got)
+
+	got, ok = elements(map[string]int{"a": 1})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{1}, got)
+
+	got, ok = elements([]interface{}{nil})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{nil}, got)
+
+	_, ok = elements("ab")
+	assert.False(t, ok)
+	_, ok = elements(nil)
+	assert.False(t, ok)
+}
@@ -0,0 +1,19 @@
+package argument
+
+import "reflect"
+
+// Eq returns a matcher for the arguments deeply equal to the specified value,
+// a nil value matches the nil arguments (see Nil)
+func Eq(value interface{}) SelfDescribingMatcher {
+	if value == nil {
+		return Describe("Eq(nil)", Nil)
+	}
+	return &describedMatcher{
+		name:     "Eq",
+		values:   []interface{}{value},
+		describe: func() string { return "Eq(" + describeValue(value) + ")" },
+		matcher: func(arg interface{}) bool {
+			return reflect.DeepEqual(value, arg)
+		},
+	}
+}
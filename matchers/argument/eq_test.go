@@ -0,0 +1,34 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEq(t *testing.T) {
+	var nilPointer *int
+	tests := []struct {
+		name  string
+		value interface{}
+		arg   interface{}
+		want  bool
+	}{
+		{name: "Should match equal values", value: "some-value", arg: "some-value", want: true},
+		{name: "Should match deeply equal values", value: []int{1, 2}, arg: []int{1, 2}, want: true},
+		{name: "Should not match different values", value: "some-value", arg: "some-other-value", want: false},
+		{name: "Should not match values of different types", value: 1, arg: int64(1), want: false},
+		{name: "Should match nil arguments if the value is nil", value: nil, arg: nilPointer, want: true},
+		{name: "Should not match not nil arguments if the value is nil", value: nil, arg: 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Eq(tt.value).Matches(tt.arg))
+		})
+	}
+}
+
+func TestEq_String(t *testing.T) {
+	assert.Equal(t, `Eq("a")`, Eq("a").String())
+	assert.Equal(t, "Eq(nil)", Eq(nil).String())
+}
@@ -0,0 +1,11 @@
+package argument
+
+import "strings"
+
+// EqualFold returns a matcher for the string arguments (including []byte and
+// fmt.Stringer) equal to the value, ignoring the case
+func EqualFold(value string) SelfDescribingMatcher {
+	return stringMatcher("EqualFold", value, func(s string) bool {
+		return strings.EqualFold(s, value)
+	})
+}
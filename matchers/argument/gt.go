@@ -0,0 +1,9 @@
+package argument
+
+// Gt returns a matcher for the arguments greater than the value, that can be a
+// number of any kind (including time.Duration) or a time.Time
+func Gt(value interface{}) SelfDescribingMatcher {
+	return orderingMatcher("Gt", value, func(cmp int) bool {
+		return cmp > 0
+	})
+}
@@ -0,0 +1,9 @@
+package argument
+
+// Gte returns a matcher for the arguments greater than or equal to the value,
+// that can be a number of any kind (including time.Duration) or a time.Time
+func Gte(value interface{}) SelfDescribingMatcher {
+	return orderingMatcher("Gte", value, func(cmp int) bool {
+		return cmp >= 0
+	})
+}
@@ -0,0 +1,32 @@
+package argument
+
+import "reflect"
+
+// HasEntry returns a matcher for the maps with at least an entry whose key and
+// value satisfy the matchers, or are equal to the values
+func HasEntry(key interface{}, value interface{}) SelfDescribingMatcher {
+	keyMatcher, valueMatcher := toMatcher(key), toMatcher(value)
+	return &describedMatcher{
+		name:     "HasEntry",
+		values:   []interface{}{key, value},
+		describe: func() string { return "HasEntry(" + describeValues([]interface{}{key, value}) + ")" },
+		matcher: func(arg interface{}) bool {
+			return hasEntry(arg, keyMatcher, valueMatcher)
+		},
+	}
+}
+
+func hasEntry(arg interface{}, keyMatcher Matcher, valueMatcher Matcher) bool {
+	value := reflect.ValueOf(arg)
+	if value.Kind() != reflect.Map {
+		return false
+	}
+
+	iter := value.MapRange()
+	for iter.Next() {
+		if keyMatcher(iter.Key().Interface()) && valueMatcher(iter.Value().Interface()) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,16 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasEntry(t *testing.T) {
+	assert.True(t, HasEntry("a", 1).Matches(map[string]int{"a": 1, "b": 2}))
+	assert.True(t, HasEntry(Any, Gt(1)).Matches(map[string]int{"a": 1, "b": 2}))
+	assert.False(t, HasEntry("a", 2).Matches(map[string]int{"a": 1, "b": 2}))
+	assert.False(t, HasEntry("a", 1).Matches([]int{1}))
+	assert.False(t, HasEntry("a", 1).Matches(nil))
+	assert.Equal(t, `HasEntry("a", 1)`, HasEntry("a", 1).String())
+}
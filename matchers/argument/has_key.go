@@ -0,0 +1,15 @@
+package argument
+
+// HasKey returns a matcher for the maps with at least a key that satisfies the
+// matcher, or equal to the value
+func HasKey(key interface{}) SelfDescribingMatcher {
+	keyMatcher := toMatcher(key)
+	return &describedMatcher{
+		name:     "HasKey",
+		values:   []interface{}{key},
+		describe: func() string { return "HasKey(" + describeValue(key) + ")" },
+		matcher: func(arg interface{}) bool {
+			return hasEntry(arg, keyMatcher, Any)
+		},
+	}
+}
@@ -0,0 +1,15 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasKey(t *testing.T) {
+	assert.True(t, HasKey("a").Matches(map[string]int{"a": 1}))
+	assert.True(t, HasKey(HasPrefix("a")).Matches(map[string]int{"abc": 1}))
+	assert.False(t, HasKey("b").Matches(map[string]int{"a": 1}))
+	assert.False(t, HasKey(0).Matches([]int{1}))
+	assert.Equal(t, `HasKey("a")`, HasKey("a").String())
+}
@@ -0,0 +1,21 @@
+package argument
+
+import "reflect"
+
+// HasLen returns a matcher for the arguments (slices, arrays, maps, strings or
+// channels) with the specified length
+func HasLen(length int) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     "HasLen",
+		values:   []interface{}{length},
+		describe: func() string { return "HasLen(" + describeValue(length) + ")" },
+		matcher: func(arg interface{}) bool {
+			value := reflect.ValueOf(arg)
+			switch value.Kind() {
+			case reflect.Array, reflect.Chan, reflect.Map, reflect.Slice, reflect.String:
+				return value.Len() == length
+			}
+			return false
+		},
+	}
+}
@@ -0,0 +1,21 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHasLen(t *testing.T) {
+	var nilSlice []int
+
+	assert.True(t, HasLen(2).Matches([]int{1, 2}))
+	assert.True(t, HasLen(1).Matches(map[string]int{"a": 1}))
+	assert.True(t, HasLen(3).Matches("abc"))
+	assert.True(t, HasLen(2).Matches([2]int{}))
+	assert.True(t, HasLen(0).Matches(nilSlice))
+	assert.False(t, HasLen(1).Matches([]int{1, 2}))
+	assert.False(t, HasLen(0).Matches(nil))
+	assert.False(t, HasLen(0).Matches(0))
+	assert.Equal(t, "HasLen(2)", HasLen(2).String())
+}
@@ -0,0 +1,11 @@
+package argument
+
+import "strings"
+
+// HasPrefix returns a matcher for the string arguments (including []byte and
+// fmt.Stringer) that begin with the prefix
+func HasPrefix(prefix string) SelfDescribingMatcher {
+	return stringMatcher("HasPrefix", prefix, func(s string) bool {
+		return strings.HasPrefix(s, prefix)
+	})
+}
@@ -0,0 +1,11 @@
+package argument
+
+import "strings"
+
+// HasSuffix returns a matcher for the string arguments (including []byte and
+// fmt.Stringer) that end with the suffix
+func HasSuffix(suffix string) SelfDescribingMatcher {
+	return stringMatcher("HasSuffix", suffix, func(s string) bool {
+		return strings.HasSuffix(s, suffix)
+	})
+}
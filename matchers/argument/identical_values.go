@@ -0,0 +1,21 @@
+package argument
+
+import (
+	"reflect"
+
+	"github.com/pasdam/mockit/internal/utils"
+)
+
+// identicalValues returns true if the values bound to two matchers are the
+// same; matchers are compared by their identity, functions by their value
+func identicalValues(a interface{}, b interface{}) bool {
+	if matcher, ok := a.(*describedMatcher); ok {
+		return matcher.Identical(b)
+	}
+
+	valueA, valueB := reflect.ValueOf(a), reflect.ValueOf(b)
+	if valueA.Kind() == reflect.Func && valueB.Kind() == reflect.Func {
+		return valueA.Type() == valueB.Type() && utils.FuncValuePointer(valueA) == utils.FuncValuePointer(valueB)
+	}
+	return reflect.DeepEqual(a, b)
+}
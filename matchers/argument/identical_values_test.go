@@ -0,0 +1,24 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_identicalValues(t *testing.T) {
+	closure := func(value interface{}) Matcher {
+		return func(arg interface{}) bool { return arg == value }
+	}
+	c := closure(1)
+
+	assert.True(t, identicalValues([]int{1, 2}, []int{1, 2}))
+	assert.False(t, identicalValues([]int{1, 2}, []int{1}))
+	assert.True(t, identicalValues(Eq(1), Eq(1)))
+	assert.False(t, identicalValues(Eq(1), Eq(2)))
+	assert.True(t, identicalValues(Nil, Nil))
+	assert.False(t, identicalValues(Nil, Zero))
+	assert.True(t, identicalValues(c, c))
+	assert.False(t, identicalValues(c, closure(1)))
+	assert.False(t, identicalValues(Matcher(Nil), Nil))
+}
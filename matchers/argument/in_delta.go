@@ -0,0 +1,35 @@
+package argument
+
+import (
+	"math"
+	"reflect"
+	"time"
+)
+
+// InDelta returns a matcher for the arguments that differ from the value at
+// most by delta; the value can be a number of any kind, or a time.Time with a
+// time.Duration delta
+func InDelta(value interface{}, delta interface{}) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     "InDelta",
+		values:   []interface{}{value, delta},
+		describe: func() string { return "InDelta(" + describeValues([]interface{}{value, delta}) + ")" },
+		matcher: func(arg interface{}) bool {
+			if expected, ok := value.(time.Time); ok {
+				actual, okActual := arg.(time.Time)
+				maxDelta, okDelta := delta.(time.Duration)
+				if !okActual || !okDelta {
+					return false
+				}
+				diff := actual.Sub(expected)
+				return -maxDelta <= diff && diff <= maxDelta
+			}
+
+			argValue, valueOf, deltaValue := reflect.ValueOf(arg), reflect.ValueOf(value), reflect.ValueOf(delta)
+			if numericKindOf(argValue) == notNumeric || numericKindOf(valueOf) == notNumeric || numericKindOf(deltaValue) == notNumeric {
+				return false
+			}
+			return math.Abs(toFloat(argValue)-toFloat(valueOf)) <= toFloat(deltaValue)
+		},
+	}
+}
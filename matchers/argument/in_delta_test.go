@@ -0,0 +1,38 @@
+package argument
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInDelta(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name  string
+		value interface{}
+		delta interface{}
+		arg   interface{}
+		want  bool
+	}{
+		{name: "Should match floats in delta", value: 1.0, delta: 0.1, arg: 1.05, want: true},
+		{name: "Should match floats at the delta", value: 1.0, delta: 0.5, arg: 0.5, want: true},
+		{name: "Should not match floats out of delta", value: 1.0, delta: 0.1, arg: 1.2, want: false},
+		{name: "Should match other numeric kinds", value: 10, delta: uint(1), arg: float32(10.5), want: true},
+		{name: "Should match times in delta", value: now, delta: time.Second, arg: now.Add(-time.Millisecond), want: true},
+		{name: "Should not match times out of delta", value: now, delta: time.Second, arg: now.Add(time.Minute), want: false},
+		{name: "Should not match times with a numeric delta", value: now, delta: 1, arg: now, want: false},
+		{name: "Should not match other types if the value is a time", value: now, delta: time.Second, arg: 1, want: false},
+		{name: "Should not match not numeric arguments", value: 1.0, delta: 0.1, arg: "1.0", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, InDelta(tt.value, tt.delta).Matches(tt.arg))
+		})
+	}
+}
+
+func TestInDelta_String(t *testing.T) {
+	assert.Equal(t, "InDelta(0.5, 0.01)", InDelta(0.5, 0.01).String())
+}
@@ -0,0 +1,9 @@
+package argument
+
+import "reflect"
+
+// IsA returns a matcher for the arguments of type T, or implementing it if T
+// is an interface; a nil argument never matches
+func IsA[T any]() SelfDescribingMatcher {
+	return IsType(reflect.TypeOf((*T)(nil)).Elem())
+}
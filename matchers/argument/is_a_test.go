@@ -0,0 +1,27 @@
+package argument
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsA(t *testing.T) {
+	var nilFile *os.File
+
+	assert.True(t, IsA[string]().Matches("some-value"))
+	assert.False(t, IsA[string]().Matches(1))
+	assert.True(t, IsA[*os.File]().Matches(nilFile))
+	assert.True(t, IsA[error]().Matches(errors.New("some-error")))
+	assert.True(t, IsA[io.Reader]().Matches(nilFile))
+	assert.False(t, IsA[io.Reader]().Matches("some-value"))
+	assert.False(t, IsA[error]().Matches(nil))
+}
+
+func TestIsA_String(t *testing.T) {
+	assert.Equal(t, "IsA(*http.Request)", IsA[*http.Request]().String())
+}
@@ -0,0 +1,24 @@
+package argument
+
+import "reflect"
+
+// IsType returns a matcher for the arguments of the specified type, or
+// implementing it if the type is an interface; a nil argument never matches
+func IsType(typeOf reflect.Type) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     "IsType",
+		values:   []interface{}{typeOf},
+		describe: func() string { return "IsA(" + typeOf.String() + ")" },
+		matcher: func(arg interface{}) bool {
+			if arg == nil {
+				return false
+			}
+
+			argType := reflect.TypeOf(arg)
+			if typeOf.Kind() == reflect.Interface {
+				return argType.Implements(typeOf)
+			}
+			return argType == typeOf
+		},
+	}
+}
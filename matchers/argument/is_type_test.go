@@ -0,0 +1,35 @@
+package argument
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIsType(t *testing.T) {
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	tests := []struct {
+		name   string
+		typeOf reflect.Type
+		arg    interface{}
+		want   bool
+	}{
+		{name: "Should match arguments of the type", typeOf: reflect.TypeOf(""), arg: "some-value", want: true},
+		{name: "Should not match arguments of other types", typeOf: reflect.TypeOf(""), arg: 1, want: false},
+		{name: "Should not match arguments of convertible types", typeOf: reflect.TypeOf(int64(0)), arg: 1, want: false},
+		{name: "Should match arguments implementing the interface", typeOf: errorType, arg: errors.New("some-error"), want: true},
+		{name: "Should not match arguments not implementing the interface", typeOf: errorType, arg: "some-error", want: false},
+		{name: "Should not match nil", typeOf: errorType, arg: nil, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, IsType(tt.typeOf).Matches(tt.arg))
+		})
+	}
+}
+
+func TestIsType_String(t *testing.T) {
+	assert.Equal(t, "IsA(error)", IsType(reflect.TypeOf((*error)(nil)).Elem()).String())
+}
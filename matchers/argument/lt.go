@@ -0,0 +1,9 @@
+package argument
+
+// Lt returns a matcher for the arguments less than the value, that can be a
+// number of any kind (including time.Duration) or a time.Time
+func Lt(value interface{}) SelfDescribingMatcher {
+	return orderingMatcher("Lt", value, func(cmp int) bool {
+		return cmp < 0
+	})
+}
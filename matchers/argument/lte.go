@@ -0,0 +1,9 @@
+package argument
+
+// Lte returns a matcher for the arguments less than or equal to the value, that
+// can be a number of any kind (including time.Duration) or a time.Time
+func Lte(value interface{}) SelfDescribingMatcher {
+	return orderingMatcher("Lte", value, func(cmp int) bool {
+		return cmp <= 0
+	})
+}
@@ -0,0 +1,18 @@
+package argument
+
+import "reflect"
+
+// Nil matches the nil arguments, including nil pointers, slices, maps,
+// channels, functions and interfaces
+func Nil(arg interface{}) bool {
+	if arg == nil {
+		return true
+	}
+
+	value := reflect.ValueOf(arg)
+	switch value.Kind() {
+	case reflect.Chan, reflect.Func, reflect.Interface, reflect.Map, reflect.Ptr, reflect.Slice, reflect.UnsafePointer:
+		return value.IsNil()
+	}
+	return false
+}
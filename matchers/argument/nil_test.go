@@ -0,0 +1,37 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNil(t *testing.T) {
+	var nilPointer *int
+	var nilSlice []int
+	var nilMap map[string]int
+	var nilChan chan int
+	var nilFunc func()
+	var nilError error
+	tests := []struct {
+		name string
+		arg  interface{}
+		want bool
+	}{
+		{name: "Should match nil", arg: nil, want: true},
+		{name: "Should match nil pointers", arg: nilPointer, want: true},
+		{name: "Should match nil slices", arg: nilSlice, want: true},
+		{name: "Should match nil maps", arg: nilMap, want: true},
+		{name: "Should match nil channels", arg: nilChan, want: true},
+		{name: "Should match nil functions", arg: nilFunc, want: true},
+		{name: "Should match nil interfaces", arg: nilError, want: true},
+		{name: "Should not match not nil pointers", arg: new(int), want: false},
+		{name: "Should not match empty slices", arg: []int{}, want: false},
+		{name: "Should not match zero values", arg: 0, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Nil(tt.arg))
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package argument
+
+// Not returns a matcher for the arguments that don't satisfy the specified
+// matcher, or that are not equal to the specified value
+func Not(matcher interface{}) SelfDescribingMatcher {
+	m := toMatcher(matcher)
+	return &describedMatcher{
+		name:     "Not",
+		values:   []interface{}{matcher},
+		describe: func() string { return "Not(" + describeValue(matcher) + ")" },
+		matcher: func(arg interface{}) bool {
+			return !m(arg)
+		},
+	}
+}
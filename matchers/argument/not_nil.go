@@ -0,0 +1,6 @@
+package argument
+
+// NotNil matches the arguments that are not nil (see Nil)
+func NotNil(arg interface{}) bool {
+	return !Nil(arg)
+}
@@ -0,0 +1,16 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotNil(t *testing.T) {
+	var nilPointer *int
+
+	assert.False(t, NotNil(nil))
+	assert.False(t, NotNil(nilPointer))
+	assert.True(t, NotNil(new(int)))
+	assert.True(t, NotNil(0))
+}
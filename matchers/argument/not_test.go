@@ -0,0 +1,19 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNot(t *testing.T) {
+	assert.False(t, Not(NotNil).Matches(1))
+	assert.True(t, Not(NotNil).Matches(nil))
+	assert.False(t, Not("some-value").Matches("some-value"))
+	assert.True(t, Not("some-value").Matches("some-other-value"))
+}
+
+func TestNot_String(t *testing.T) {
+	assert.Equal(t, "Not(argument.Zero)", Not(Zero).String())
+	assert.Equal(t, `Not("a")`, Not("a").String())
+}
@@ -0,0 +1,7 @@
+package argument
+
+// NotZero matches the arguments that are not the zero value of their type
+// (see Zero)
+func NotZero(arg interface{}) bool {
+	return !Zero(arg)
+}
@@ -0,0 +1,14 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotZero(t *testing.T) {
+	assert.False(t, NotZero(nil))
+	assert.False(t, NotZero(0))
+	assert.True(t, NotZero(1))
+	assert.True(t, NotZero("some-value"))
+}
@@ -0,0 +1,25 @@
+package argument
+
+import "reflect"
+
+// numericKind groups the kinds that are compared in the same way
+type numericKind int
+
+const (
+	notNumeric numericKind = iota
+	signedKind
+	unsignedKind
+	floatKind
+)
+
+func numericKindOf(value reflect.Value) numericKind {
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return signedKind
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return unsignedKind
+	case reflect.Float32, reflect.Float64:
+		return floatKind
+	}
+	return notNumeric
+}
@@ -0,0 +1,18 @@
+package argument
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_numericKindOf(t *testing.T) {
+	assert.Equal(t, signedKind, numericKindOf(reflect.ValueOf(int8(1))))
+	assert.Equal(t, signedKind, numericKindOf(reflect.ValueOf(time.Second)))
+	assert.Equal(t, unsignedKind, numericKindOf(reflect.ValueOf(uintptr(1))))
+	assert.Equal(t, floatKind, numericKindOf(reflect.ValueOf(float32(1))))
+	assert.Equal(t, notNumeric, numericKindOf(reflect.ValueOf("1")))
+	assert.Equal(t, notNumeric, numericKindOf(reflect.ValueOf(nil)))
+}
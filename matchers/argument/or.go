@@ -0,0 +1,6 @@
+package argument
+
+// Or is an alias of AnyOf
+func Or(matchers ...interface{}) SelfDescribingMatcher {
+	return anyOf("Or", matchers)
+}
@@ -0,0 +1,16 @@
+package argument
+
+// orderingMatcher returns a matcher for the arguments that, compared with the
+// bound, satisfy the accept function; arguments that can't be compared never
+// match
+func orderingMatcher(name string, bound interface{}, accept func(cmp int) bool) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     name,
+		values:   []interface{}{bound},
+		describe: func() string { return name + "(" + describeValue(bound) + ")" },
+		matcher: func(arg interface{}) bool {
+			cmp, ok := compare(arg, bound)
+			return ok && accept(cmp)
+		},
+	}
+}
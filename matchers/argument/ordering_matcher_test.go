@@ -0,0 +1,40 @@
+package argument
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOrderingMatchers(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name    string
+		matcher SelfDescribingMatcher
+		arg     interface{}
+		want    bool
+	}{
+		{name: "Gt should match greater values", matcher: Gt(1), arg: 2, want: true},
+		{name: "Gt should not match equal values", matcher: Gt(1), arg: 1, want: false},
+		{name: "Gte should match equal values", matcher: Gte(1), arg: uint(1), want: true},
+		{name: "Gte should not match less values", matcher: Gte(1), arg: 0.5, want: false},
+		{name: "Lt should match less values", matcher: Lt(time.Second), arg: time.Millisecond, want: true},
+		{name: "Lt should not match equal values", matcher: Lt(time.Second), arg: time.Second, want: false},
+		{name: "Lte should match equal values", matcher: Lte(now), arg: now, want: true},
+		{name: "Lte should not match greater values", matcher: Lte(now), arg: now.Add(time.Second), want: false},
+		{name: "Should not match values that can't be compared", matcher: Gt(1), arg: "2", want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.matcher.Matches(tt.arg))
+		})
+	}
+}
+
+func TestOrderingMatchers_String(t *testing.T) {
+	assert.Equal(t, "Gt(1)", Gt(1).String())
+	assert.Equal(t, "Gte(1.5)", Gte(1.5).String())
+	assert.Equal(t, "Lt(1s)", Lt(time.Second).String())
+	assert.Equal(t, "Lte(-1)", Lte(-1).String())
+}
@@ -0,0 +1,11 @@
+package argument
+
+import "regexp"
+
+// Regexp returns a matcher for the string arguments (including []byte and
+// fmt.Stringer) that match the regular expression; it panics if the
+// expression is not valid
+func Regexp(pattern string) SelfDescribingMatcher {
+	re := regexp.MustCompile(pattern)
+	return stringMatcher("Regexp", pattern, re.MatchString)
+}
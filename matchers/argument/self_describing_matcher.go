@@ -0,0 +1,12 @@
+package argument
+
+// SelfDescribingMatcher is a matcher that describes itself, so it can be
+// printed in a readable way in the failure messages
+type SelfDescribingMatcher interface {
+
+	// Matches returns true if the argument satisfies the matcher
+	Matches(arg interface{}) bool
+
+	// String returns the description of the matcher
+	String() string
+}
@@ -0,0 +1,15 @@
+package argument
+
+// stringMatcher returns a matcher for the arguments whose string value (see
+// asString) satisfies the match function
+func stringMatcher(name string, pattern string, match func(s string) bool) SelfDescribingMatcher {
+	return &describedMatcher{
+		name:     name,
+		values:   []interface{}{pattern},
+		describe: func() string { return name + "(" + describeValue(pattern) + ")" },
+		matcher: func(arg interface{}) bool {
+			s, ok := asString(arg)
+			return ok && match(s)
+		},
+	}
+}
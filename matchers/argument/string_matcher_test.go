@@ -0,0 +1,47 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStringMatchers(t *testing.T) {
+	tests := []struct {
+		name    string
+		matcher SelfDescribingMatcher
+		arg     interface{}
+		want    bool
+	}{
+		{name: "HasPrefix should match strings with the prefix", matcher: HasPrefix("http://"), arg: "http://host", want: true},
+		{name: "HasPrefix should not match strings without the prefix", matcher: HasPrefix("http://"), arg: "https://host", want: false},
+		{name: "HasSuffix should match byte slices with the suffix", matcher: HasSuffix(".json"), arg: []byte("file.json"), want: true},
+		{name: "HasSuffix should not match strings without the suffix", matcher: HasSuffix(".json"), arg: "file.yaml", want: false},
+		{name: "Contains should match strings with the substring", matcher: Contains("error"), arg: "some error occurred", want: true},
+		{name: "Contains should not match strings without the substring", matcher: Contains("error"), arg: "all good", want: false},
+		{name: "Regexp should match strings matching the expression", matcher: Regexp(`^id-\d+$`), arg: "id-42", want: true},
+		{name: "Regexp should not match strings not matching the expression", matcher: Regexp(`^id-\d+$`), arg: "id-a", want: false},
+		{name: "EqualFold should match strings ignoring the case", matcher: EqualFold("GET"), arg: "get", want: true},
+		{name: "EqualFold should not match different strings", matcher: EqualFold("GET"), arg: "post", want: false},
+		{name: "Should not match arguments that are not strings", matcher: Contains("1"), arg: 1, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.matcher.Matches(tt.arg))
+		})
+	}
+}
+
+func TestStringMatchers_String(t *testing.T) {
+	assert.Equal(t, `HasPrefix("http://")`, HasPrefix("http://").String())
+	assert.Equal(t, `HasSuffix(".json")`, HasSuffix(".json").String())
+	assert.Equal(t, `Contains("error")`, Contains("error").String())
+	assert.Equal(t, `Regexp("^id-\\d+$")`, Regexp(`^id-\d+$`).String())
+	assert.Equal(t, `EqualFold("GET")`, EqualFold("GET").String())
+}
+
+func TestRegexp_ShouldPanicIfTheExpressionIsNotValid(t *testing.T) {
+	assert.Panics(t, func() {
+		Regexp("(")
+	})
+}
@@ -0,0 +1,14 @@
+package argument
+
+import "reflect"
+
+// toFloat converts the numeric value to float64
+func toFloat(value reflect.Value) float64 {
+	switch numericKindOf(value) {
+	case signedKind:
+		return float64(value.Int())
+	case unsignedKind:
+		return float64(value.Uint())
+	}
+	return value.Float()
+}
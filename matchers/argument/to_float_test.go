@@ -0,0 +1,14 @@
+package argument
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toFloat(t *testing.T) {
+	assert.Equal(t, -1.0, toFloat(reflect.ValueOf(-1)))
+	assert.Equal(t, 2.0, toFloat(reflect.ValueOf(uint8(2))))
+	assert.Equal(t, 2.5, toFloat(reflect.ValueOf(float32(2.5))))
+}
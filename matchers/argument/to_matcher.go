@@ -0,0 +1,15 @@
+package argument
+
+// toMatcher returns the value itself if it is a matcher, otherwise a matcher
+// for the arguments equal to it (see Eq)
+func toMatcher(value interface{}) Matcher {
+	switch matcher := value.(type) {
+	case SelfDescribingMatcher:
+		return matcher.Matches
+	case Matcher:
+		return matcher
+	case func(arg interface{}) bool:
+		return matcher
+	}
+	return Eq(value).Matches
+}
@@ -0,0 +1,20 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_toMatcher(t *testing.T) {
+	captor := &Captor{}
+
+	assert.True(t, toMatcher(Any)("some-value"))
+	assert.True(t, toMatcher(Eq("some-value"))("some-value"))
+	assert.False(t, toMatcher(Eq("some-value"))("some-other-value"))
+	assert.True(t, toMatcher(captor.Capture)("some-value"))
+	assert.Equal(t, "some-value", captor.Value)
+	assert.True(t, toMatcher("some-value")("some-value"))
+	assert.False(t, toMatcher("some-value")("some-other-value"))
+	assert.True(t, toMatcher(nil)(nil))
+}
@@ -0,0 +1,5 @@
+package argument
+
+// VariadicMatcher is a function used to match all the remaining variadic
+// arguments of a call
+type VariadicMatcher func(args []interface{}) bool
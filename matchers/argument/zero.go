@@ -0,0 +1,8 @@
+package argument
+
+import "reflect"
+
+// Zero matches the arguments that are the zero value of their type, or nil
+func Zero(arg interface{}) bool {
+	return arg == nil || reflect.ValueOf(arg).IsZero()
+}
@@ -0,0 +1,31 @@
+package argument
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestZero(t *testing.T) {
+	var nilPointer *int
+	tests := []struct {
+		name string
+		arg  interface{}
+		want bool
+	}{
+		{name: "Should match nil", arg: nil, want: true},
+		{name: "Should match nil pointers", arg: nilPointer, want: true},
+		{name: "Should match zero ints", arg: 0, want: true},
+		{name: "Should match empty strings", arg: "", want: true},
+		{name: "Should match zero structs", arg: struct{ a int }{}, want: true},
+		{name: "Should not match not zero ints", arg: 1, want: false},
+		{name: "Should not match not empty strings", arg: "some-value", want: false},
+		{name: "Should not match not zero structs", arg: struct{ a int }{a: 1}, want: false},
+		{name: "Should not match empty slices", arg: []int{}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, Zero(tt.arg))
+		})
+	}
+}
@@ -0,0 +1,7 @@
+package mockit
+
+import "reflect"
+
+// answer computes the outputs of a call from its arguments, nil outputs mean
+// that the real method should be called
+type answer func(in []reflect.Value) []reflect.Value
@@ -0,0 +1,8 @@
+package mockit
+
+// Arg returns a placeholder for the argument at the specified index of the
+// actual call, it can be used in Stub.Return to return the argument itself,
+// i.e. Return(Arg(0), nil)
+func Arg(index int) ArgPlaceholder {
+	return ArgPlaceholder{index: index}
+}
@@ -0,0 +1,6 @@
+package mockit
+
+// ArgPlaceholder is a placeholder for an argument of the actual call, see Arg
+type ArgPlaceholder struct {
+	index int
+}
@@ -0,0 +1,104 @@
+package mockit
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// argSetter writes a value through the argument at the specified index, that
+// must be a pointer, a slice or a map
+type argSetter struct {
+	index int
+	value interface{}
+}
+
+func newArgSetter(typeOf reflect.Type, index int, value interface{}) (*argSetter, error) {
+	if index < 0 || (!typeOf.IsVariadic() && index >= typeOf.NumIn()) {
+		return nil, fmt.Errorf("Index %d is out of range, the function has %d arguments", index, typeOf.NumIn())
+	}
+
+	argType := inTypeProvider(typeOf)(index)
+	if argType.Kind() != reflect.Interface {
+		// the type of interface arguments is known only when the call is made
+		_, err := argValue(argType, index, value)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &argSetter{
+		index: index,
+		value: value,
+	}, nil
+}
+
+func (s *argSetter) apply(in []reflect.Value) error {
+	if s.index >= len(in) {
+		return fmt.Errorf("Index %d is out of range, the call has %d arguments", s.index, len(in))
+	}
+
+	arg := in[s.index]
+	if arg.Kind() == reflect.Interface {
+		arg = arg.Elem()
+	}
+	if !arg.IsValid() {
+		return fmt.Errorf("Argument at index %d is nil", s.index)
+	}
+
+	value, err := argValue(arg.Type(), s.index, s.value)
+	if err != nil {
+		return err
+	}
+
+	switch arg.Kind() {
+	case reflect.Ptr:
+		if arg.IsNil() {
+			return fmt.Errorf("Argument at index %d is a nil pointer", s.index)
+		}
+		arg.Elem().Set(value)
+
+	case reflect.Slice:
+		if value.Len() > arg.Len() {
+			return fmt.Errorf("Cannot copy %d elements into the argument at index %d, its length is %d", value.Len(), s.index, arg.Len())
+		}
+		reflect.Copy(arg, value)
+
+	case reflect.Map:
+		if arg.IsNil() && value.Len() > 0 {
+			return fmt.Errorf("Argument at index %d is a nil map", s.index)
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			arg.SetMapIndex(iter.Key(), iter.Value())
+		}
+	}
+
+	return nil
+}
+
+// argValue converts the value to the one to write through an argument of the
+// specified type
+func argValue(argType reflect.Type, index int, value interface{}) (reflect.Value, error) {
+	var expected reflect.Type
+	switch argType.Kind() {
+	case reflect.Ptr:
+		expected = argType.Elem()
+
+	case reflect.Slice, reflect.Map:
+		expected = argType
+
+	default:
+		return reflect.Value{}, fmt.Errorf("Argument at index %d (%v) is not a pointer, a slice or a map", index, argType)
+	}
+
+	expectedProvider := func(int) reflect.Type { return expected }
+	values := interfacesArrayToValuesArray([]interface{}{value}, expectedProvider)
+	err := verifyValues(1, expectedProvider, values)
+	if err != nil || !values[0].Type().AssignableTo(expected) {
+		// the type of matchers is accepted by verifyValues, but they can't be
+		// written through arguments
+		return reflect.Value{}, fmt.Errorf("Invalid value for the argument at index %d, expected type %v: actual type %v", index, expected, values[0].Type())
+	}
+
+	return values[0], nil
+}
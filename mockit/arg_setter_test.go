@@ -0,0 +1,139 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func argSetterTestFunc(p *int, s []byte, m map[string]int, v interface{}, n int, rest ...*string) {}
+
+func Test_newArgSetter(t *testing.T) {
+	typeOf := reflect.TypeOf(argSetterTestFunc)
+	tests := []struct {
+		name    string
+		index   int
+		value   interface{}
+		wantErr bool
+	}{
+		{name: "Should fail if the index is negative", index: -1, value: 1, wantErr: true},
+		{name: "Should fail if the index is out of range", index: 6, value: 1, wantErr: true},
+		{name: "Should fail if the argument is not a pointer, a slice or a map", index: 4, value: 1, wantErr: true},
+		{name: "Should fail if the value is not assignable to the pointed type", index: 0, value: "some-value", wantErr: true},
+		{name: "Should fail if the value is a matcher", index: 0, value: argument.Any, wantErr: true},
+		{name: "Should fail if the value is not a slice of the same type", index: 1, value: "some-value", wantErr: true},
+		{name: "Should fail if the value is not a map of the same type", index: 2, value: map[string]string{}, wantErr: true},
+		{name: "Should accept a value assignable to the pointed type", index: 0, value: 3, wantErr: false},
+		{name: "Should accept nil as the zero value", index: 0, value: nil, wantErr: false},
+		{name: "Should accept a slice of the same type", index: 1, value: []byte("some-value"), wantErr: false},
+		{name: "Should accept a map of the same type", index: 2, value: map[string]int{"a": 1}, wantErr: false},
+		{name: "Should accept any value for interface arguments", index: 3, value: "some-value", wantErr: false},
+		{name: "Should accept variadic arguments", index: 7, value: "some-value", wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := newArgSetter(typeOf, tt.index, tt.value)
+
+			if tt.wantErr {
+				assert.NotNil(t, err)
+				assert.Nil(t, got)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, &argSetter{index: tt.index, value: tt.value}, got)
+			}
+		})
+	}
+}
+
+func Test_argSetter_apply(t *testing.T) {
+	t.Run("Should set the pointed value", func(t *testing.T) {
+		value := 0
+
+		err := (&argSetter{index: 0, value: 3}).apply([]reflect.Value{reflect.ValueOf(&value)})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 3, value)
+	})
+
+	t.Run("Should copy the elements into the slice", func(t *testing.T) {
+		value := make([]byte, 4)
+
+		err := (&argSetter{index: 0, value: []byte("abc")}).apply([]reflect.Value{reflect.ValueOf(value)})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []byte{'a', 'b', 'c', 0}, value)
+	})
+
+	t.Run("Should set the entries of the map", func(t *testing.T) {
+		value := map[string]int{"a": 1, "b": 2}
+
+		err := (&argSetter{index: 0, value: map[string]int{"b": 3, "c": 4}}).apply([]reflect.Value{reflect.ValueOf(value)})
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]int{"a": 1, "b": 3, "c": 4}, value)
+	})
+
+	t.Run("Should use the dynamic type of interface arguments", func(t *testing.T) {
+		var value interface{}
+		var arg interface{} = &value
+		in := []reflect.Value{reflect.ValueOf(&arg).Elem()}
+
+		err := (&argSetter{index: 0, value: "some-value"}).apply(in)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "some-value", value)
+	})
+
+	t.Run("Should fail if the argument is missing", func(t *testing.T) {
+		err := (&argSetter{index: 1, value: 3}).apply([]reflect.Value{reflect.ValueOf(new(int))})
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should fail if the argument is nil", func(t *testing.T) {
+		var arg interface{}
+		in := []reflect.Value{reflect.ValueOf(&arg).Elem()}
+
+		err := (&argSetter{index: 0, value: 3}).apply(in)
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should fail if the argument is a nil pointer", func(t *testing.T) {
+		var value *int
+
+		err := (&argSetter{index: 0, value: 3}).apply([]reflect.Value{reflect.ValueOf(value)})
+
+		assert.NotNil(t, err)
+	})
+
+	t.Run("Should fail if the value type doesn't match the dynamic type of the argument", func(t *testing.T) {
+		value := 0
+		var arg interface{} = &value
+		in := []reflect.Value{reflect.ValueOf(&arg).Elem()}
+
+		err := (&argSetter{index: 0, value: "some-value"}).apply(in)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, 0, value)
+	})
+
+	t.Run("Should fail if the slice is too short", func(t *testing.T) {
+		value := make([]byte, 2)
+
+		err := (&argSetter{index: 0, value: []byte("abc")}).apply([]reflect.Value{reflect.ValueOf(value)})
+
+		assert.NotNil(t, err)
+		assert.Equal(t, []byte{0, 0}, value)
+	})
+
+	t.Run("Should fail if the map is nil", func(t *testing.T) {
+		var value map[string]int
+
+		err := (&argSetter{index: 0, value: map[string]int{"a": 1}}).apply([]reflect.Value{reflect.ValueOf(value)})
+
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,11 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Arg(t *testing.T) {
+	assert.Equal(t, ArgPlaceholder{index: 2}, Arg(2))
+}
@@ -0,0 +1,19 @@
+package mockit
+
+import "reflect"
+
+// argsAnswer returns the specified outputs, replacing the ones in placeholders
+// (output index to argument index) with the actual arguments
+func argsAnswer(out []reflect.Value, placeholders map[int]int) answer {
+	return func(in []reflect.Value) []reflect.Value {
+		result := make([]reflect.Value, len(out))
+		copy(result, out)
+		for outIndex, inIndex := range placeholders {
+			if inIndex < len(in) {
+				// a missing variadic argument is returned as a zero value
+				result[outIndex] = in[inIndex]
+			}
+		}
+		return result
+	}
+}
@@ -0,0 +1,24 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_argsAnswer(t *testing.T) {
+	out := []reflect.Value{reflect.ValueOf(""), reflect.ValueOf(1), reflect.ValueOf("")}
+	a := argsAnswer(out, map[int]int{0: 1, 2: 3})
+
+	got := a([]reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b"), reflect.ValueOf("c"), reflect.ValueOf("d")})
+	assert.Equal(t, "b", got[0].Interface())
+	assert.Equal(t, 1, got[1].Interface())
+	assert.Equal(t, "d", got[2].Interface())
+
+	got = a([]reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b")})
+	assert.Equal(t, "b", got[0].Interface())
+	assert.Equal(t, 1, got[1].Interface())
+	assert.Equal(t, "", got[2].Interface())
+	assert.Equal(t, "", out[0].Interface(), "the outputs should not be modified")
+}
@@ -8,6 +8,8 @@ import (
 
 var matcherType = reflect.TypeOf(argument.Any)
 
+var selfDescribingMatcherType = reflect.TypeOf((*argument.SelfDescribingMatcher)(nil)).Elem()
+
 func argumentsMatch(expected reflect.Value, actual reflect.Value, enableMatcher bool) bool {
 	equal := reflect.DeepEqual(expected.Interface(), actual.Interface())
 	if equal {
@@ -23,5 +25,9 @@ func argumentsMatch(expected reflect.Value, actual reflect.Value, enableMatcher
 		return expected.Call([]reflect.Value{actual})[0].Bool()
 	}
 
+	if enableMatcher && expected.Type().Implements(selfDescribingMatcherType) {
+		return expected.Interface().(argument.SelfDescribingMatcher).Matches(actual.Interface())
+	}
+
 	return false
 }
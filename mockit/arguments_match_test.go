@@ -101,6 +101,33 @@ func Test_argumentsMatch(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "Self-describing matcher matches",
+			args: args{
+				expected:      reflect.ValueOf(argument.Eq(-100)),
+				actual:        reflect.ValueOf(-100),
+				enableMatcher: true,
+			},
+			want: true,
+		},
+		{
+			name: "Self-describing matcher matches, but is not enabled",
+			args: args{
+				expected:      reflect.ValueOf(argument.Eq(-100)),
+				actual:        reflect.ValueOf(-100),
+				enableMatcher: false,
+			},
+			want: false,
+		},
+		{
+			name: "Self-describing matcher does not match",
+			args: args{
+				expected:      reflect.ValueOf(argument.Eq(100)),
+				actual:        reflect.ValueOf(-100),
+				enableMatcher: true,
+			},
+			want: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
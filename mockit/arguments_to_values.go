@@ -0,0 +1,14 @@
+package mockit
+
+import "reflect"
+
+func argumentsToValues(args []interface{}, typeOf reflect.Type) []reflect.Value {
+	if typeOf.IsVariadic() && len(args) == typeOf.NumIn() {
+		last := args[len(args)-1]
+		if last != nil && reflect.TypeOf(last).AssignableTo(typeOf.In(len(args)-1)) {
+			return expandVariadic(typeOf, interfacesArrayToValuesArray(args, typeOf.In))
+		}
+	}
+
+	return interfacesArrayToValuesArray(args, inTypeProvider(typeOf))
+}
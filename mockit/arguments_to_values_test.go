@@ -0,0 +1,59 @@
+package mockit
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_argumentsToValues(t *testing.T) {
+	tests := []struct {
+		name   string
+		args   []interface{}
+		typeOf reflect.Type
+		want   []interface{}
+	}{
+		{
+			name:   "Non variadic function",
+			args:   []interface{}{"a"},
+			typeOf: reflect.TypeOf(filepath.Base),
+			want:   []interface{}{"a"},
+		},
+		{
+			name:   "Variadic function, individual arguments",
+			args:   []interface{}{"a", "b", "c"},
+			typeOf: reflect.TypeOf(filepath.Join),
+			want:   []interface{}{"a", "b", "c"},
+		},
+		{
+			name:   "Variadic function, nil individual argument",
+			args:   []interface{}{"%v", nil},
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			want:   []interface{}{"%v", nil},
+		},
+		{
+			name:   "Variadic function, slice argument",
+			args:   []interface{}{[]string{"a", "b"}},
+			typeOf: reflect.TypeOf(filepath.Join),
+			want:   []interface{}{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := argumentsToValues(tt.args, tt.typeOf)
+
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want); i++ {
+				assert.Equal(t, tt.want[i], got[i].Interface())
+			}
+		})
+	}
+
+	got := argumentsToValues([]interface{}{"%v", argument.AnyRemaining}, reflect.TypeOf(fmt.Sprintf))
+	assert.Equal(t, 2, len(got))
+	assert.True(t, isVariadicMatcher(got[1]))
+}
@@ -0,0 +1,17 @@
+package mockit
+
+import (
+	"reflect"
+)
+
+// bindReceiver returns a function of the specified type, that calls the
+// method with the receiver as first argument
+func bindReceiver(method reflect.Value, receiver reflect.Value, fnType reflect.Type) reflect.Value {
+	return reflect.MakeFunc(fnType, func(in []reflect.Value) []reflect.Value {
+		in = append([]reflect.Value{receiver}, in...)
+		if fnType.IsVariadic() {
+			return method.CallSlice(in)
+		}
+		return method.Call(in)
+	})
+}
@@ -0,0 +1,17 @@
+package mockit
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_bindReceiver(t *testing.T) {
+	method := reflect.ValueOf(fmt.Sprintf)
+
+	got := bindReceiver(method, reflect.ValueOf("%s-%d"), reflect.TypeOf(func(...interface{}) string { return "" }))
+
+	assert.Equal(t, "a-1", got.Interface().(func(...interface{}) string)("a", 1))
+}
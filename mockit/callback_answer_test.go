@@ -0,0 +1,49 @@
+package mockit
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_callbackAnswer(t *testing.T) {
+	tests := []struct {
+		name string
+		fn   interface{}
+		in   []reflect.Value
+		want []interface{}
+	}{
+		{
+			name: "Should call the function with the arguments",
+			fn:   strings.ToUpper,
+			in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			want: []interface{}{"SOME-ARG"},
+		},
+		{
+			name: "Should call a variadic function",
+			fn:   fmt.Sprintf,
+			in:   []reflect.Value{reflect.ValueOf("%s-%d"), reflect.ValueOf("a"), reflect.ValueOf(1)},
+			want: []interface{}{"a-1"},
+		},
+		{
+			name: "Should return an empty output for a function without results",
+			fn:   func(string) {},
+			in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			want: []interface{}{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := callbackAnswer(reflect.ValueOf(tt.fn))(tt.in)
+
+			assert.NotNil(t, got)
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want); i++ {
+				assert.Equal(t, tt.want[i], got[i].Interface())
+			}
+		})
+	}
+}
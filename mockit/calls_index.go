@@ -1,25 +1,102 @@
 package mockit
 
-import "reflect"
+import (
+	"errors"
+	"reflect"
+	"sync"
+)
 
 type callsIndex struct {
-	in  [][]reflect.Value
-	out [][]reflect.Value
+	calls []*mockedCall
+	lock  sync.Mutex
 }
 
-func (i *callsIndex) Add(in []reflect.Value, out []reflect.Value) {
-	// TODO: search for matching arguments and replace in case
-	i.in = append(i.in, in)
-	i.out = append(i.out, out)
+// Add appends the call to the index, or replaces the one with identical
+// arguments, so that the latest stub wins
+func (i *callsIndex) Add(call *mockedCall) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for j := 0; j < len(i.calls); j++ {
+		if identicalArguments(i.calls[j].in, call.in) {
+			i.calls[j] = call
+			return
+		}
+	}
+	i.calls = append(i.calls, call)
+}
+
+// AddAnswer appends the answer to the ones of the call
+func (i *callsIndex) AddAnswer(call *mockedCall, a answer) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	call.answers = append(call.answers, a)
+}
+
+// Calls returns a copy of the calls, that can be safely read while the
+// original ones are used
+func (i *callsIndex) Calls() []*mockedCall {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	result := make([]*mockedCall, len(i.calls))
+	for j := 0; j < len(i.calls); j++ {
+		call := *i.calls[j]
+		result[j] = &call
+	}
+	return result
 }
 
 func (i *callsIndex) MockedOutFor(in []reflect.Value) ([]reflect.Value, error) {
-	index, err := findCall(i.in, in, func(fromCalls, in []reflect.Value) bool {
-		return callsMatch(fromCalls, in, true)
-	})
-	if err != nil {
-		return nil, err
+	a := i.answerFor(in)
+	if a == nil {
+		return nil, errors.New("Unable to find a call with the specified input parameters")
 	}
 
-	return i.out[index], nil
+	// the answer is invoked without holding the lock, as it might call the
+	// mock again (i.e. from a callback)
+	return a(in), nil
+}
+
+// Remove deletes the call from the index, it returns false if it wasn't found
+func (i *callsIndex) Remove(call *mockedCall) bool {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for j := 0; j < len(i.calls); j++ {
+		if i.calls[j] == call {
+			i.calls = append(i.calls[:j:j], i.calls[j+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// Reset deletes all the calls from the index
+func (i *callsIndex) Reset() {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	i.calls = nil
+}
+
+// SetTimes sets the number of times the call is expected to be used
+func (i *callsIndex) SetTimes(call *mockedCall, times *int) {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	call.times = times
+}
+
+func (i *callsIndex) answerFor(in []reflect.Value) answer {
+	i.lock.Lock()
+	defer i.lock.Unlock()
+
+	for j := 0; j < len(i.calls); j++ {
+		if callsMatch(i.calls[j].in, in, true) {
+			return i.calls[j].next()
+		}
+	}
+	return nil
 }
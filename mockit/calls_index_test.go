@@ -4,17 +4,36 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/pasdam/mockit/matchers/argument"
 	"github.com/stretchr/testify/assert"
 )
 
+func assertCallsIndexEqual(t *testing.T, expected *callsIndex, actual *callsIndex) {
+	assert.Equal(t, len(expected.calls), len(actual.calls))
+	for i := 0; i < len(expected.calls) && i < len(actual.calls); i++ {
+		assert.Equal(t, len(expected.calls[i].in), len(actual.calls[i].in))
+		for j := 0; j < len(expected.calls[i].in); j++ {
+			assert.Equal(t, expected.calls[i].in[j].Interface(), actual.calls[i].in[j].Interface())
+		}
+
+		assert.Equal(t, len(expected.calls[i].answers), len(actual.calls[i].answers))
+		for j := 0; j < len(expected.calls[i].answers) && j < len(actual.calls[i].answers); j++ {
+			expectedOut := expected.calls[i].answers[j](nil)
+			actualOut := actual.calls[i].answers[j](nil)
+			assert.Equal(t, len(expectedOut), len(actualOut))
+			for k := 0; k < len(expectedOut); k++ {
+				assert.Equal(t, expectedOut[k].Interface(), actualOut[k].Interface())
+			}
+		}
+	}
+}
+
 func Test_callsIndex_Add(t *testing.T) {
 	type fields struct {
-		in  [][]reflect.Value
-		out [][]reflect.Value
+		calls []*mockedCall
 	}
 	type args struct {
-		in  []reflect.Value
-		out []reflect.Value
+		call *mockedCall
 	}
 	tests := []struct {
 		name   string
@@ -25,44 +44,82 @@ func Test_callsIndex_Add(t *testing.T) {
 		{
 			name: "Fist entry",
 			fields: fields{
-				in:  nil,
-				out: nil,
+				calls: nil,
 			},
 			args: args{
-				in:  []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
-				out: []reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)},
+				call: &mockedCall{
+					in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)})},
+				},
 			},
 			want: fields{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)})},
+					},
 				},
 			},
 		},
 		{
 			name: "Second entry",
 			fields: fields{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)})},
+					},
 				},
 			},
 			args: args{
-				in:  []reflect.Value{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
-				out: []reflect.Value{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)},
+				call: &mockedCall{
+					in:      []reflect.Value{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)})},
+				},
 			},
 			want: fields{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
-					{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)})},
+					},
+				},
+			},
+		},
+		{
+			name: "Should replace the call with identical arguments",
+			fields: fields{
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)})},
+					},
 				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-first-out-value"), reflect.ValueOf(200)},
-					{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)},
+			},
+			args: args{
+				call: &mockedCall{
+					in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-third-out-value"), reflect.ValueOf(500)})},
+				},
+			},
+			want: fields{
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-first-in-value"), reflect.ValueOf(100)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-third-out-value"), reflect.ValueOf(500)})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-second-in-value"), reflect.ValueOf(300)},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-second-out-value"), reflect.ValueOf(400)})},
+					},
 				},
 			},
 		},
@@ -70,62 +127,117 @@ func Test_callsIndex_Add(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			idx := &callsIndex{
-				in:  tt.fields.in,
-				out: tt.fields.out,
+				calls: tt.fields.calls,
 			}
-			idx.Add(tt.args.in, tt.args.out)
-
-			assert.Equal(t, len(tt.want.in), len(idx.in))
-			for i := 0; i < len(tt.want.in); i++ {
-				assert.Equal(t, len(tt.want.in[i]), len(idx.in[i]))
+			idx.Add(tt.args.call)
 
-				for j := 0; j < len(tt.want.in[i]); j++ {
-					assert.Equal(t, tt.want.in[i][j].Interface(), idx.in[i][j].Interface())
-				}
-			}
-			assert.Equal(t, len(tt.want.out), len(idx.out))
-			for i := 0; i < len(tt.want.out); i++ {
-				assert.Equal(t, len(tt.want.out[i]), len(idx.out[i]))
-
-				for j := 0; j < len(tt.want.out[i]); j++ {
-					assert.Equal(t, tt.want.out[i][j].Interface(), idx.out[i][j].Interface())
-				}
-			}
+			assertCallsIndexEqual(t, &callsIndex{calls: tt.want.calls}, idx)
 		})
 	}
 }
 
 func Test_callsIndex_MockedOutFor(t *testing.T) {
-	type fields struct {
-		in  [][]reflect.Value
-		out [][]reflect.Value
-	}
 	type args struct {
 		in []reflect.Value
 	}
 	tests := []struct {
-		name    string
-		fields  fields
-		args    args
-		want    []reflect.Value
-		wantErr bool
+		name     string
+		calls    []*mockedCall
+		args     args
+		want     []reflect.Value
+		wantHits []int
+		wantErr  bool
 	}{
-		// TODO: Add test cases.
+		{
+			name:  "No mocked calls",
+			calls: nil,
+			args: args{
+				in: []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want:     nil,
+			wantHits: []int{},
+			wantErr:  true,
+		},
+		{
+			name: "No matching call",
+			calls: []*mockedCall{
+				{
+					in:      []reflect.Value{reflect.ValueOf("some-other-arg")},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-out")})},
+				},
+			},
+			args: args{
+				in: []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want:     nil,
+			wantHits: []int{0},
+			wantErr:  true,
+		},
+		{
+			name: "Should return the first matching call",
+			calls: []*mockedCall{
+				{
+					in:      []reflect.Value{reflect.ValueOf("some-other-arg")},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-other-out")})},
+				},
+				{
+					in:      []reflect.Value{reflect.ValueOf(argument.Any)},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-out")})},
+				},
+				{
+					in:      []reflect.Value{reflect.ValueOf("some-arg")},
+					answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-last-out")})},
+				},
+			},
+			args: args{
+				in: []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want:     []reflect.Value{reflect.ValueOf("some-out")},
+			wantHits: []int{0, 1, 0},
+			wantErr:  false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			i := &callsIndex{
-				in:  tt.fields.in,
-				out: tt.fields.out,
+				calls: tt.calls,
 			}
 			got, err := i.MockedOutFor(tt.args.in)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("callsIndex.MockedOutFor() error = %v, wantErr %v", err, tt.wantErr)
 				return
 			}
-			if !reflect.DeepEqual(got, tt.want) {
-				t.Errorf("callsIndex.MockedOutFor() = %v, want %v", got, tt.want)
+			assert.Equal(t, len(tt.want), len(got))
+			for j := 0; j < len(tt.want); j++ {
+				assert.Equal(t, tt.want[j].Interface(), got[j].Interface())
+			}
+			hits := make([]int, 0, len(i.calls))
+			for j := 0; j < len(i.calls); j++ {
+				hits = append(hits, i.calls[j].hits)
 			}
+			assert.Equal(t, tt.wantHits, hits)
 		})
 	}
 }
+
+func Test_callsIndex_Remove(t *testing.T) {
+	first := &mockedCall{in: []reflect.Value{reflect.ValueOf("some-first-in-value")}}
+	second := &mockedCall{in: []reflect.Value{reflect.ValueOf("some-second-in-value")}}
+	idx := &callsIndex{calls: []*mockedCall{first, second}}
+	calls := idx.calls
+
+	assert.True(t, idx.Remove(first))
+	assert.Equal(t, []*mockedCall{second}, idx.calls)
+	assert.Equal(t, first, calls[0], "the previous slice should not be modified")
+	assert.False(t, idx.Remove(first))
+	assert.True(t, idx.Remove(second))
+	assert.Equal(t, 0, len(idx.calls))
+}
+
+func Test_callsIndex_Reset(t *testing.T) {
+	idx := &callsIndex{calls: []*mockedCall{{in: []reflect.Value{reflect.ValueOf("some-in-value")}}}}
+
+	idx.Reset()
+
+	assert.Nil(t, idx.calls)
+}
@@ -0,0 +1,74 @@
+package mockit
+
+import (
+	"reflect"
+	"sync"
+)
+
+type callsJournal struct {
+	entries []*journalEntry
+	lock    sync.Mutex
+	nextSeq uint64
+}
+
+// Find returns the first entry, with a sequence number greater or equal than
+// from, that was recorded by the specified mock with matching arguments
+func (j *callsJournal) Find(from uint64, mock *instanceMock, in []reflect.Value) *journalEntry {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	for i := 0; i < len(j.entries); i++ {
+		entry := j.entries[i]
+		if entry.seq >= from && entry.mock == mock && callsMatch(in, entry.in, true) {
+			return entry
+		}
+	}
+	return nil
+}
+
+// Record appends the call to the journal
+func (j *callsJournal) Record(mock *instanceMock, in []reflect.Value) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	j.entries = append(j.entries, &journalEntry{
+		in:   in,
+		mock: mock,
+		seq:  j.nextSeq,
+	})
+	j.nextSeq++
+}
+
+// Remove deletes all the entries recorded by the specified mock
+func (j *callsJournal) Remove(mock *instanceMock) {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	entries := j.entries[:0]
+	for i := 0; i < len(j.entries); i++ {
+		if j.entries[i].mock != mock {
+			entries = append(entries, j.entries[i])
+		}
+	}
+	for i := len(entries); i < len(j.entries); i++ {
+		j.entries[i] = nil
+	}
+	j.entries = entries
+}
+
+// Timeline returns the entries recorded by the specified mocks, in order
+func (j *callsJournal) Timeline(mocks []*instanceMock) []*journalEntry {
+	j.lock.Lock()
+	defer j.lock.Unlock()
+
+	var result []*journalEntry
+	for i := 0; i < len(j.entries); i++ {
+		for k := 0; k < len(mocks); k++ {
+			if j.entries[i].mock == mocks[k] {
+				result = append(result, j.entries[i])
+				break
+			}
+		}
+	}
+	return result
+}
@@ -0,0 +1,171 @@
+package mockit
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_callsJournal_Find(t *testing.T) {
+	mock1 := &instanceMock{}
+	mock2 := &instanceMock{}
+	journal := &callsJournal{
+		entries: []*journalEntry{
+			{in: []reflect.Value{reflect.ValueOf("some-arg")}, mock: mock1, seq: 0},
+			{in: []reflect.Value{reflect.ValueOf("some-arg")}, mock: mock2, seq: 1},
+			{in: []reflect.Value{reflect.ValueOf("some-other-arg")}, mock: mock1, seq: 2},
+			{in: []reflect.Value{reflect.ValueOf("some-arg")}, mock: mock1, seq: 3},
+		},
+		nextSeq: 4,
+	}
+	type args struct {
+		from uint64
+		mock *instanceMock
+		in   []reflect.Value
+	}
+	tests := []struct {
+		name    string
+		args    args
+		wantSeq int
+	}{
+		{
+			name: "Should return the first matching entry",
+			args: args{
+				from: 0,
+				mock: mock1,
+				in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			wantSeq: 0,
+		},
+		{
+			name: "Should skip entries before the specified sequence number",
+			args: args{
+				from: 1,
+				mock: mock1,
+				in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			wantSeq: 3,
+		},
+		{
+			name: "Should skip entries of other mocks",
+			args: args{
+				from: 0,
+				mock: mock2,
+				in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			wantSeq: 1,
+		},
+		{
+			name: "Should use matchers",
+			args: args{
+				from: 1,
+				mock: mock1,
+				in:   []reflect.Value{reflect.ValueOf(argument.Any)},
+			},
+			wantSeq: 2,
+		},
+		{
+			name: "Should return nil if no entry matches",
+			args: args{
+				from: 2,
+				mock: mock2,
+				in:   []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			wantSeq: -1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := journal.Find(tt.args.from, tt.args.mock, tt.args.in)
+
+			if tt.wantSeq < 0 {
+				assert.Nil(t, got)
+			} else {
+				assert.Equal(t, uint64(tt.wantSeq), got.seq)
+			}
+		})
+	}
+}
+
+func Test_callsJournal_Record(t *testing.T) {
+	mock := &instanceMock{}
+	journal := &callsJournal{}
+
+	journal.Record(mock, []reflect.Value{reflect.ValueOf("some-first-arg")})
+	journal.Record(mock, []reflect.Value{reflect.ValueOf("some-second-arg")})
+
+	assert.Equal(t, uint64(2), journal.nextSeq)
+	assert.Equal(t, 2, len(journal.entries))
+	assert.Equal(t, uint64(0), journal.entries[0].seq)
+	assert.Equal(t, mock, journal.entries[0].mock)
+	assert.Equal(t, "some-first-arg", journal.entries[0].in[0].Interface())
+	assert.Equal(t, uint64(1), journal.entries[1].seq)
+	assert.Equal(t, mock, journal.entries[1].mock)
+	assert.Equal(t, "some-second-arg", journal.entries[1].in[0].Interface())
+}
+
+func Test_callsJournal_Remove(t *testing.T) {
+	mock1 := &instanceMock{}
+	mock2 := &instanceMock{}
+	journal := &callsJournal{
+		entries: []*journalEntry{
+			{mock: mock1, seq: 0},
+			{mock: mock2, seq: 1},
+			{mock: mock1, seq: 2},
+		},
+		nextSeq: 3,
+	}
+
+	journal.Remove(mock1)
+
+	assert.Equal(t, uint64(3), journal.nextSeq)
+	assert.Equal(t, 1, len(journal.entries))
+	assert.Equal(t, mock2, journal.entries[0].mock)
+}
+
+func Test_callsJournal_Timeline(t *testing.T) {
+	mock1 := &instanceMock{}
+	mock2 := &instanceMock{}
+	mock3 := &instanceMock{}
+	journal := &callsJournal{
+		entries: []*journalEntry{
+			{mock: mock1, seq: 0},
+			{mock: mock2, seq: 1},
+			{mock: mock3, seq: 2},
+			{mock: mock1, seq: 3},
+		},
+		nextSeq: 4,
+	}
+
+	got := journal.Timeline([]*instanceMock{mock1, mock3})
+
+	assert.Equal(t, 3, len(got))
+	assert.Equal(t, uint64(0), got[0].seq)
+	assert.Equal(t, uint64(2), got[1].seq)
+	assert.Equal(t, uint64(3), got[2].seq)
+}
+
+func Test_callsJournal_ShouldBeSafeForConcurrentUse(t *testing.T) {
+	journal := &callsJournal{}
+	mocks := []*instanceMock{{}, {}}
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(mock *instanceMock) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				journal.Record(mock, nil)
+				journal.Find(0, mock, nil)
+				journal.Timeline(mocks)
+			}
+		}(mocks[i%2])
+	}
+	wg.Wait()
+
+	assert.Equal(t, 1000, len(journal.Timeline(mocks)))
+	assert.Equal(t, uint64(1000), journal.nextSeq)
+}
@@ -3,7 +3,14 @@ package mockit
 import "reflect"
 
 func callsMatch(expectedArgs []reflect.Value, actualArgs []reflect.Value, enableMatchers bool) bool {
-	if len(expectedArgs) != len(actualArgs) {
+	var variadicMatcher reflect.Value
+	if enableMatchers && len(expectedArgs) > 0 && isVariadicMatcher(expectedArgs[len(expectedArgs)-1]) {
+		variadicMatcher = expectedArgs[len(expectedArgs)-1]
+		expectedArgs = expectedArgs[:len(expectedArgs)-1]
+		if len(actualArgs) < len(expectedArgs) {
+			return false
+		}
+	} else if len(expectedArgs) != len(actualArgs) {
 		return false
 	}
 
@@ -13,5 +20,13 @@ func callsMatch(expectedArgs []reflect.Value, actualArgs []reflect.Value, enable
 		}
 	}
 
+	if variadicMatcher.IsValid() {
+		remaining := make([]interface{}, 0, len(actualArgs)-len(expectedArgs))
+		for i := len(expectedArgs); i < len(actualArgs); i++ {
+			remaining = append(remaining, actualArgs[i].Interface())
+		}
+		return variadicMatcher.Call([]reflect.Value{reflect.ValueOf(remaining)})[0].Bool()
+	}
+
 	return true
 }
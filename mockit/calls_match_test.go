@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/pasdam/mockit/matchers/argument"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -90,3 +91,77 @@ func Test_callsMatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_callsMatch_withVariadicMatcher(t *testing.T) {
+	noneMatcher := func(args []interface{}) bool { return len(args) == 0 }
+	type args struct {
+		expected       []reflect.Value
+		actual         []reflect.Value
+		enableMatchers bool
+	}
+	tests := []struct {
+		name string
+		args args
+		want bool
+	}{
+		{
+			name: "Should match any remaining arguments",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.AnyRemaining)},
+				actual:         []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b"), reflect.ValueOf("c")},
+				enableMatchers: true,
+			},
+			want: true,
+		},
+		{
+			name: "Should match no remaining arguments",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.AnyRemaining)},
+				actual:         []reflect.Value{reflect.ValueOf("a")},
+				enableMatchers: true,
+			},
+			want: true,
+		},
+		{
+			name: "Should not match if the fixed arguments are different",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.AnyRemaining)},
+				actual:         []reflect.Value{reflect.ValueOf("b"), reflect.ValueOf("c")},
+				enableMatchers: true,
+			},
+			want: false,
+		},
+		{
+			name: "Should not match if there are less arguments than the fixed ones",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b"), reflect.ValueOf(argument.AnyRemaining)},
+				actual:         []reflect.Value{reflect.ValueOf("a")},
+				enableMatchers: true,
+			},
+			want: false,
+		},
+		{
+			name: "Should use the result of the variadic matcher",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.VariadicMatcher(noneMatcher))},
+				actual:         []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b")},
+				enableMatchers: true,
+			},
+			want: false,
+		},
+		{
+			name: "Should not use the variadic matcher if matchers are disabled",
+			args: args{
+				expected:       []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.AnyRemaining)},
+				actual:         []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b")},
+				enableMatchers: false,
+			},
+			want: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, callsMatch(tt.args.expected, tt.args.actual, tt.args.enableMatchers))
+		})
+	}
+}
@@ -0,0 +1,28 @@
+package mockit
+
+import "reflect"
+
+func closestCall(calls []*mockedCall, in []reflect.Value) *mockedCall {
+	var closest *mockedCall
+	closestScore := -1
+	for i := 0; i < len(calls); i++ {
+		if len(calls[i].in) != len(in) {
+			if closest == nil {
+				closest = calls[i]
+			}
+			continue
+		}
+
+		score := 0
+		for j := 0; j < len(in); j++ {
+			if argumentsMatch(calls[i].in[j], in[j], false) {
+				score++
+			}
+		}
+		if score > closestScore {
+			closest = calls[i]
+			closestScore = score
+		}
+	}
+	return closest
+}
@@ -0,0 +1,69 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_closestCall(t *testing.T) {
+	call1 := &mockedCall{in: []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(1)}}
+	call2 := &mockedCall{in: []reflect.Value{reflect.ValueOf("b"), reflect.ValueOf(2)}}
+	call3 := &mockedCall{in: []reflect.Value{reflect.ValueOf("b")}}
+	type args struct {
+		calls []*mockedCall
+		in    []reflect.Value
+	}
+	tests := []struct {
+		name string
+		args args
+		want *mockedCall
+	}{
+		{
+			name: "No mocked calls",
+			args: args{
+				calls: nil,
+				in:    []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(1)},
+			},
+			want: nil,
+		},
+		{
+			name: "Should return the call with most matching arguments",
+			args: args{
+				calls: []*mockedCall{call1, call2},
+				in:    []reflect.Value{reflect.ValueOf("b"), reflect.ValueOf(3)},
+			},
+			want: call2,
+		},
+		{
+			name: "Should return the first call if the score is the same",
+			args: args{
+				calls: []*mockedCall{call1, call2},
+				in:    []reflect.Value{reflect.ValueOf("c"), reflect.ValueOf(3)},
+			},
+			want: call1,
+		},
+		{
+			name: "Should prefer calls with the same number of arguments",
+			args: args{
+				calls: []*mockedCall{call3, call2},
+				in:    []reflect.Value{reflect.ValueOf("c"), reflect.ValueOf(3)},
+			},
+			want: call2,
+		},
+		{
+			name: "Should return a call with a different number of arguments if it's the only one",
+			args: args{
+				calls: []*mockedCall{call3},
+				in:    []reflect.Value{reflect.ValueOf("b"), reflect.ValueOf(3)},
+			},
+			want: call3,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, closestCall(tt.args.calls, tt.args.in))
+		})
+	}
+}
@@ -0,0 +1,12 @@
+package mockit
+
+import "reflect"
+
+// containsCall returns true if one of the calls matches the arguments, which
+// can contain matchers
+func containsCall(calls [][]reflect.Value, in []reflect.Value) bool {
+	_, err := findCall(calls, in, func(fromCalls, in []reflect.Value) bool {
+		return callsMatch(in, fromCalls, true)
+	})
+	return err == nil
+}
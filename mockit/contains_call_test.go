@@ -0,0 +1,21 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_containsCall(t *testing.T) {
+	calls := [][]reflect.Value{
+		{reflect.ValueOf("some-argument")},
+		{reflect.ValueOf("some-other-argument")},
+	}
+
+	assert.True(t, containsCall(calls, []reflect.Value{reflect.ValueOf("some-other-argument")}))
+	assert.True(t, containsCall(calls, []reflect.Value{reflect.ValueOf(argument.Any)}))
+	assert.False(t, containsCall(calls, []reflect.Value{reflect.ValueOf("not-recorded")}))
+	assert.False(t, containsCall(nil, []reflect.Value{reflect.ValueOf(argument.Any)}))
+}
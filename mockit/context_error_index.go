@@ -0,0 +1,24 @@
+package mockit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+)
+
+// contextErrorIndex returns the index of the output where to return the
+// context error, the function must have a context as first argument
+func contextErrorIndex(typeOf reflect.Type) (int, error) {
+	contextType := reflect.TypeOf((*context.Context)(nil)).Elem()
+	if typeOf.NumIn() == 0 || typeOf.In(0) != contextType {
+		return -1, errors.New("The first argument of the function is not a context.Context")
+	}
+
+	errorType := reflect.TypeOf((*error)(nil)).Elem()
+	for i := typeOf.NumOut() - 1; i >= 0; i-- {
+		if typeOf.Out(i) == errorType {
+			return i, nil
+		}
+	}
+	return -1, errors.New("The function has no error output")
+}
@@ -0,0 +1,35 @@
+package mockit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func contextErrorIndexTestFunc01(ctx context.Context) (error, int, error, string) {
+	return nil, 0, nil, ""
+}
+
+func Test_contextErrorIndex(t *testing.T) {
+	tests := []struct {
+		name    string
+		target  interface{}
+		want    int
+		wantErr bool
+	}{
+		{name: "Should fail if the function has no arguments", target: func() error { return nil }, want: -1, wantErr: true},
+		{name: "Should fail if the first argument is not a context", target: func(string, context.Context) error { return nil }, want: -1, wantErr: true},
+		{name: "Should fail if the function has no error output", target: func(context.Context) string { return "" }, want: -1, wantErr: true},
+		{name: "Should return the last error output", target: contextErrorIndexTestFunc01, want: 2, wantErr: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := contextErrorIndex(reflect.TypeOf(tt.target))
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
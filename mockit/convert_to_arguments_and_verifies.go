@@ -0,0 +1,18 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+)
+
+func convertToArgumentsAndVerifies(t *testing.T, values []interface{}, typeOf reflect.Type) []reflect.Value {
+	result := argumentsToValues(values, typeOf)
+
+	err := verifyArguments(typeOf, result)
+	if err != nil {
+		t.Errorf("Invalid arguments. %s", err.Error())
+		return nil
+	}
+
+	return result
+}
@@ -0,0 +1,45 @@
+package mockit
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_convertToArgumentsAndVerifies(t *testing.T) {
+	typeOf := reflect.TypeOf(filepath.Join)
+	tests := []struct {
+		name       string
+		values     []interface{}
+		want       []interface{}
+		shouldFail bool
+	}{
+		{
+			name:       "Valid arguments",
+			values:     []interface{}{"a", "b"},
+			want:       []interface{}{"a", "b"},
+			shouldFail: false,
+		},
+		{
+			name:       "Invalid arguments",
+			values:     []interface{}{"a", 1},
+			want:       nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+
+			got := convertToArgumentsAndVerifies(mockT, tt.values, typeOf)
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want); i++ {
+				assert.Equal(t, tt.want[i], got[i].Interface())
+			}
+		})
+	}
+}
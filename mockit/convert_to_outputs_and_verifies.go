@@ -0,0 +1,46 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+)
+
+// convertToOutputsAndVerifies converts the values to the outputs of a function
+// of the specified type, the ones that are argument placeholders are returned
+// as a map from the output index to the argument index
+func convertToOutputsAndVerifies(t *testing.T, values []interface{}, typeOf reflect.Type) ([]reflect.Value, map[int]int) {
+	if len(values) != typeOf.NumOut() {
+		t.Errorf("Invalid arguments. Expected values count (%d) is different than the actual size (%d)", typeOf.NumOut(), len(values))
+		return nil, nil
+	}
+
+	var placeholders map[int]int
+	inType := inTypeProvider(typeOf)
+	out := interfacesArrayToValuesArray(values, typeOf.Out)
+	for i, value := range values {
+		placeholder, ok := value.(ArgPlaceholder)
+		if !ok {
+			continue
+		}
+
+		if placeholder.index < 0 || (!typeOf.IsVariadic() && placeholder.index >= typeOf.NumIn()) {
+			t.Errorf("Invalid arguments. Argument index %d at index %d is out of range, the function has %d arguments", placeholder.index, i, typeOf.NumIn())
+			return nil, nil
+		}
+		if placeholders == nil {
+			placeholders = make(map[int]int)
+		}
+		placeholders[i] = placeholder.index
+		// the actual argument is not known yet, use a value of its type to
+		// verify that it can be returned
+		out[i] = reflect.Zero(inType(placeholder.index))
+	}
+
+	err := verifyValues(typeOf.NumOut(), typeOf.Out, out)
+	if err != nil {
+		t.Errorf("Invalid arguments. %s", err.Error())
+		return nil, nil
+	}
+
+	return out, placeholders
+}
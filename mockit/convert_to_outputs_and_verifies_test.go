@@ -0,0 +1,89 @@
+package mockit
+
+import (
+	"reflect"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func convertToOutputsAndVerifiesTestFunc01(a int, b interface{}, c ...string) (int, float64, string) {
+	return 0, 0, ""
+}
+
+func Test_convertToOutputsAndVerifies(t *testing.T) {
+	f01 := reflect.TypeOf(convertToOutputsAndVerifiesTestFunc01)
+	tests := []struct {
+		name             string
+		values           []interface{}
+		want             []interface{}
+		wantPlaceholders map[int]int
+		wantErr          bool
+	}{
+		{
+			name:   "Should convert all values",
+			values: []interface{}{1, 1.2, "some-string"},
+			want:   []interface{}{1, 1.2, "some-string"},
+		},
+		{
+			name:   "Should convert nil to zero values",
+			values: []interface{}{nil, nil, "some-string"},
+			want:   []interface{}{0, 0.0, "some-string"},
+		},
+		{
+			name:             "Should replace the argument placeholders",
+			values:           []interface{}{Arg(0), 1.2, Arg(3)},
+			want:             []interface{}{0, 1.2, ""},
+			wantPlaceholders: map[int]int{0: 0, 2: 3},
+		},
+		{
+			name:    "Should fail if the number of values is different than the outputs",
+			values:  []interface{}{1, 1.2},
+			wantErr: true,
+		},
+		{
+			name:    "Should fail if a value is not assignable to the output",
+			values:  []interface{}{1, "some-string", "some-string"},
+			wantErr: true,
+		},
+		{
+			name:    "Should fail if the argument index is negative",
+			values:  []interface{}{Arg(-1), 1.2, "some-string"},
+			wantErr: true,
+		},
+		{
+			name:    "Should fail if the argument is not assignable to the output",
+			values:  []interface{}{1, 1.2, Arg(1)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+
+			got, gotPlaceholders := convertToOutputsAndVerifies(mockT, tt.values, f01)
+
+			assert.Equal(t, tt.wantErr, mockT.Failed())
+			assert.Equal(t, tt.wantPlaceholders, gotPlaceholders)
+			if tt.wantErr {
+				assert.Nil(t, got)
+				return
+			}
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want); i++ {
+				assert.Equal(t, tt.want[i], got[i].Interface())
+			}
+		})
+	}
+}
+
+func Test_convertToOutputsAndVerifies_ShouldFailIfTheArgumentIndexIsOutOfRange(t *testing.T) {
+	mockT := new(testing.T)
+
+	got, gotPlaceholders := convertToOutputsAndVerifies(mockT, []interface{}{Arg(1)}, reflect.TypeOf(strconv.Itoa))
+
+	assert.True(t, mockT.Failed())
+	assert.Nil(t, got)
+	assert.Nil(t, gotPlaceholders)
+}
@@ -0,0 +1,13 @@
+package mockit
+
+import "reflect"
+
+func countCalls(calls [][]reflect.Value, in []reflect.Value) int {
+	count := 0
+	for i := 0; i < len(calls); i++ {
+		if callsMatch(in, calls[i], true) {
+			count++
+		}
+	}
+	return count
+}
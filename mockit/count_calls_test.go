@@ -0,0 +1,68 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_countCalls(t *testing.T) {
+	type args struct {
+		calls [][]reflect.Value
+		in    []reflect.Value
+	}
+	tests := []struct {
+		name string
+		args args
+		want int
+	}{
+		{
+			name: "No calls recorded",
+			args: args{
+				calls: nil,
+				in:    []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want: 0,
+		},
+		{
+			name: "No matching calls",
+			args: args{
+				calls: [][]reflect.Value{
+					{reflect.ValueOf("some-other-arg")},
+				},
+				in: []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want: 0,
+		},
+		{
+			name: "Some matching calls",
+			args: args{
+				calls: [][]reflect.Value{
+					{reflect.ValueOf("some-arg")},
+					{reflect.ValueOf("some-other-arg")},
+					{reflect.ValueOf("some-arg")},
+				},
+				in: []reflect.Value{reflect.ValueOf("some-arg")},
+			},
+			want: 2,
+		},
+		{
+			name: "Matching calls using a matcher",
+			args: args{
+				calls: [][]reflect.Value{
+					{reflect.ValueOf("some-arg")},
+					{reflect.ValueOf("some-other-arg")},
+				},
+				in: []reflect.Value{reflect.ValueOf(argument.Any)},
+			},
+			want: 2,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, countCalls(tt.args.calls, tt.args.in))
+		})
+	}
+}
@@ -0,0 +1,58 @@
+package mockit
+
+import (
+	"context"
+	"reflect"
+	"time"
+)
+
+// delay blocks a call before it is answered
+type delay struct {
+	// errIndex is the index of the output where to return the context error,
+	// -1 if the context is ignored
+	errIndex int
+
+	// wait blocks until the call can be answered, or until done is closed, in
+	// which case it returns false
+	wait func(done <-chan struct{}) bool
+}
+
+func blockUntilDelay(ch <-chan struct{}, errIndex int) *delay {
+	return &delay{
+		errIndex: errIndex,
+		wait: func(done <-chan struct{}) bool {
+			select {
+			case <-ch:
+				return true
+			case <-done:
+				return false
+			}
+		},
+	}
+}
+
+func durationDelay(d time.Duration, errIndex int) *delay {
+	return &delay{
+		errIndex: errIndex,
+		wait: func(done <-chan struct{}) bool {
+			timer := time.NewTimer(d)
+			defer timer.Stop()
+
+			select {
+			case <-timer.C:
+				return true
+			case <-done:
+				return false
+			}
+		},
+	}
+}
+
+// contextOf returns the context of the call, nil if the delay ignores it
+func (d *delay) contextOf(in []reflect.Value) context.Context {
+	if d.errIndex < 0 || len(in) == 0 || !in[0].IsValid() || !in[0].CanInterface() {
+		return nil
+	}
+	ctx, _ := in[0].Interface().(context.Context)
+	return ctx
+}
@@ -0,0 +1,26 @@
+package mockit
+
+import "reflect"
+
+// delayAnswer waits for the delays and then computes the outputs with the
+// specified answer; if the context of the call is done before, it returns the
+// default outputs with the context error
+func delayAnswer(delays []*delay, defaultOut []reflect.Value, a answer) answer {
+	return func(in []reflect.Value) []reflect.Value {
+		for _, d := range delays {
+			ctx := d.contextOf(in)
+			var done <-chan struct{}
+			if ctx != nil {
+				done = ctx.Done()
+			}
+
+			if !d.wait(done) {
+				out := make([]reflect.Value, len(defaultOut))
+				copy(out, defaultOut)
+				out[d.errIndex] = reflect.ValueOf(ctx.Err())
+				return out
+			}
+		}
+		return a(in)
+	}
+}
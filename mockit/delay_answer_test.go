@@ -0,0 +1,37 @@
+package mockit
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_delayAnswer(t *testing.T) {
+	defaultOut := []reflect.Value{reflect.ValueOf(""), reflect.Zero(reflect.TypeOf((*error)(nil)).Elem())}
+	out := []reflect.Value{reflect.ValueOf("some-value"), reflect.ValueOf(errors.New("some-error"))}
+
+	t.Run("Should compute the outputs after the delays", func(t *testing.T) {
+		ch := make(chan struct{})
+		close(ch)
+		a := delayAnswer([]*delay{blockUntilDelay(ch, -1), blockUntilDelay(ch, 1)}, defaultOut, fixedAnswer(out))
+
+		got := a([]reflect.Value{reflect.ValueOf(context.Background())})
+
+		assert.Equal(t, out, got)
+	})
+
+	t.Run("Should return the context error if it is done first", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		a := delayAnswer([]*delay{blockUntilDelay(make(chan struct{}), 1)}, defaultOut, fixedAnswer(out))
+
+		got := a([]reflect.Value{reflect.ValueOf(&ctx).Elem()})
+
+		assert.Equal(t, "", got[0].Interface())
+		assert.Equal(t, context.Canceled, got[1].Interface())
+		assert.Nil(t, defaultOut[1].Interface(), "the default outputs should not be modified")
+	})
+}
@@ -0,0 +1,46 @@
+package mockit
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_blockUntilDelay(t *testing.T) {
+	ch := make(chan struct{})
+	done := make(chan struct{})
+	d := blockUntilDelay(ch, 1)
+	assert.Equal(t, 1, d.errIndex)
+
+	close(done)
+	assert.False(t, d.wait(done))
+	close(ch)
+	assert.True(t, d.wait(nil))
+}
+
+func Test_durationDelay(t *testing.T) {
+	done := make(chan struct{})
+	d := durationDelay(time.Hour, -1)
+	assert.Equal(t, -1, d.errIndex)
+
+	close(done)
+	assert.False(t, d.wait(done))
+
+	start := time.Now()
+	assert.True(t, durationDelay(10*time.Millisecond, -1).wait(nil))
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+}
+
+func Test_delay_contextOf(t *testing.T) {
+	ctx := context.Background()
+	var nilCtx context.Context
+	ctxValue := reflect.ValueOf(&ctx).Elem()
+
+	assert.Equal(t, ctx, (&delay{errIndex: 1}).contextOf([]reflect.Value{ctxValue}))
+	assert.Nil(t, (&delay{errIndex: -1}).contextOf([]reflect.Value{ctxValue}))
+	assert.Nil(t, (&delay{errIndex: 1}).contextOf([]reflect.Value{}))
+	assert.Nil(t, (&delay{errIndex: 1}).contextOf([]reflect.Value{reflect.ValueOf(&nilCtx).Elem()}))
+}
@@ -0,0 +1,20 @@
+package mockit
+
+import "reflect"
+
+func expandVariadic(typeOf reflect.Type, in []reflect.Value) []reflect.Value {
+	if !typeOf.IsVariadic() || len(in) != typeOf.NumIn() {
+		return in
+	}
+
+	last := in[len(in)-1]
+	result := make([]reflect.Value, 0, len(in)-1+last.Len())
+	result = append(result, in[:len(in)-1]...)
+	for i := 0; i < last.Len(); i++ {
+		// copy the element, as the caller might reuse the slice's backing array
+		value := reflect.New(last.Type().Elem()).Elem()
+		value.Set(last.Index(i))
+		result = append(result, value)
+	}
+	return result
+}
@@ -0,0 +1,63 @@
+package mockit
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_expandVariadic(t *testing.T) {
+	tests := []struct {
+		name   string
+		typeOf reflect.Type
+		in     []reflect.Value
+		want   []interface{}
+	}{
+		{
+			name:   "Non variadic function",
+			typeOf: reflect.TypeOf(filepath.Base),
+			in:     []reflect.Value{reflect.ValueOf("a")},
+			want:   []interface{}{"a"},
+		},
+		{
+			name:   "Variadic function, already expanded",
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			in:     []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b"), reflect.ValueOf("c")},
+			want:   []interface{}{"a", "b", "c"},
+		},
+		{
+			name:   "Variadic function, empty slice",
+			typeOf: reflect.TypeOf(filepath.Join),
+			in:     []reflect.Value{reflect.ValueOf([]string(nil))},
+			want:   []interface{}{},
+		},
+		{
+			name:   "Variadic function, slice",
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			in:     []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf([]interface{}{"b", 1})},
+			want:   []interface{}{"a", "b", 1},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := expandVariadic(tt.typeOf, tt.in)
+
+			assert.Equal(t, len(tt.want), len(got))
+			for i := 0; i < len(tt.want); i++ {
+				assert.Equal(t, tt.want[i], got[i].Interface())
+			}
+		})
+	}
+}
+
+func Test_expandVariadic_shouldCopyTheVariadicArguments(t *testing.T) {
+	variadic := []string{"b"}
+
+	got := expandVariadic(reflect.TypeOf(filepath.Join), []reflect.Value{reflect.ValueOf(variadic)})
+	variadic[0] = "c"
+
+	assert.Equal(t, "b", got[0].Interface())
+}
@@ -0,0 +1,17 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_fixedAnswer(t *testing.T) {
+	out := []reflect.Value{reflect.ValueOf("some-out")}
+
+	got := fixedAnswer(out)
+
+	assert.Equal(t, out, got([]reflect.Value{reflect.ValueOf("some-in")}))
+	assert.Nil(t, fixedAnswer(nil)(nil))
+}
@@ -0,0 +1,187 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func0R0 creates a new type-safe Mock of a function with no arguments and no
+// results
+func Func0R0(t *testing.T, fn func(), options ...Option) *Func0R0Mock {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func0R0Mock{mock: mock}
+}
+
+// Func0R0Mock is a type-safe Mock of a function with no arguments and no
+// results
+type Func0R0Mock struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func0R0Mock) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func0R0Mock) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func0R0Mock) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func0R0Mock) Remove(stub *Func0R0Stub) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func0R0Mock) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func0R0Mock) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func0R0Mock) Verify() {
+	m.mock.Verify()
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func0R0Mock) VerifyAtLeast(times int) {
+	m.mock.VerifyAtLeast(times)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func0R0Mock) VerifyAtMost(times int) {
+	m.mock.VerifyAtMost(times)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func0R0Mock) VerifyBetween(min int, max int) {
+	m.mock.VerifyBetween(min, max)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func0R0Mock) VerifyNever() {
+	m.mock.VerifyNever()
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func0R0Mock) VerifyTimes(times int) {
+	m.mock.VerifyTimes(times)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func0R0Mock) VerifyWithin(timeout time.Duration) {
+	m.mock.VerifyWithin(timeout)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func0R0Mock) WaitForCall(ctx context.Context) <-chan error {
+	return m.mock.WaitForCall(ctx)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func0R0Mock) With() *Func0R0Stub {
+	return &Func0R0Stub{stub: m.mock.With()}
+}
+
+// Func0R0Stub is a type-safe Stub of a function with no arguments and no
+// results
+type Func0R0Stub struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func0R0Stub) BlockUntil(ch <-chan struct{}) *Func0R0Stub {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func0R0Stub) CallRealMethod() *TypedOngoingStub[*Func0R0Stub] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func0R0Stub) Delay(d time.Duration) *Func0R0Stub {
+	s.stub.Delay(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func0R0Stub) DoAndReturn(fn func()) *TypedOngoingStub[*Func0R0Stub] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func0R0Stub) Panic(value interface{}) *TypedOngoingStub[*Func0R0Stub] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func0R0Stub) PanicWithError(err error) *TypedOngoingStub[*Func0R0Stub] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func0R0Stub) Return() *TypedOngoingStub[*Func0R0Stub] {
+	return s.ongoing(s.stub.Return())
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func0R0Stub) ReturnDefaults() *TypedOngoingStub[*Func0R0Stub] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func0R0Stub) Times(times int) *Func0R0Stub {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func0R0Stub) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func0R0Stub] {
+	return &TypedOngoingStub[*Func0R0Stub]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func0R0Stub {
+			return &Func0R0Stub{stub: stub}
+		},
+	}
+}
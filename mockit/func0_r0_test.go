@@ -0,0 +1,38 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var typedFunc0R0Calls int
+
+//go:noinline
+func typedFunc0R0() {
+	typedFunc0R0Calls++
+}
+
+func Test_Func0R0_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func0R0(t, typedFunc0R0)
+	m.With().Return().Then().CallRealMethod()
+
+	typedFunc0R0()
+	assert.Equal(t, 0, typedFunc0R0Calls)
+	typedFunc0R0()
+	assert.Equal(t, 1, typedFunc0R0Calls)
+	m.VerifyTimes(2)
+	typedFunc0R0Calls = 0
+}
+
+func Test_Func0R0_ShouldCallTheCallback(t *testing.T) {
+	m := Func0R0(t, typedFunc0R0)
+	m.With().DoAndReturn(func() {
+		typedFunc0R0Calls += 10
+	})
+
+	typedFunc0R0()
+	assert.Equal(t, 10, typedFunc0R0Calls)
+	typedFunc0R0Calls = 0
+	m.Verify()
+}
@@ -0,0 +1,187 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func0R2 creates a new type-safe Mock of a function with no arguments and two
+// results
+func Func0R2[R1, R2 any](t *testing.T, fn func() (R1, R2), options ...Option) *Func0R2Mock[R1, R2] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func0R2Mock[R1, R2]{mock: mock}
+}
+
+// Func0R2Mock is a type-safe Mock of a function with no arguments and two
+// results
+type Func0R2Mock[R1, R2 any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func0R2Mock[R1, R2]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func0R2Mock[R1, R2]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func0R2Mock[R1, R2]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func0R2Mock[R1, R2]) Remove(stub *Func0R2Stub[R1, R2]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func0R2Mock[R1, R2]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func0R2Mock[R1, R2]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func0R2Mock[R1, R2]) Verify() {
+	m.mock.Verify()
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func0R2Mock[R1, R2]) VerifyAtLeast(times int) {
+	m.mock.VerifyAtLeast(times)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func0R2Mock[R1, R2]) VerifyAtMost(times int) {
+	m.mock.VerifyAtMost(times)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func0R2Mock[R1, R2]) VerifyBetween(min int, max int) {
+	m.mock.VerifyBetween(min, max)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func0R2Mock[R1, R2]) VerifyNever() {
+	m.mock.VerifyNever()
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func0R2Mock[R1, R2]) VerifyTimes(times int) {
+	m.mock.VerifyTimes(times)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func0R2Mock[R1, R2]) VerifyWithin(timeout time.Duration) {
+	m.mock.VerifyWithin(timeout)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func0R2Mock[R1, R2]) WaitForCall(ctx context.Context) <-chan error {
+	return m.mock.WaitForCall(ctx)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func0R2Mock[R1, R2]) With() *Func0R2Stub[R1, R2] {
+	return &Func0R2Stub[R1, R2]{stub: m.mock.With()}
+}
+
+// Func0R2Stub is a type-safe Stub of a function with no arguments and two
+// results
+type Func0R2Stub[R1, R2 any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func0R2Stub[R1, R2]) BlockUntil(ch <-chan struct{}) *Func0R2Stub[R1, R2] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func0R2Stub[R1, R2]) CallRealMethod() *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func0R2Stub[R1, R2]) Delay(d time.Duration) *Func0R2Stub[R1, R2] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func0R2Stub[R1, R2]) DoAndReturn(fn func() (R1, R2)) *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func0R2Stub[R1, R2]) Panic(value interface{}) *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func0R2Stub[R1, R2]) PanicWithError(err error) *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func0R2Stub[R1, R2]) Return(r1 R1, r2 R2) *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return s.ongoing(s.stub.Return(r1, r2))
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func0R2Stub[R1, R2]) ReturnDefaults() *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func0R2Stub[R1, R2]) Times(times int) *Func0R2Stub[R1, R2] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func0R2Stub[R1, R2]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func0R2Stub[R1, R2]] {
+	return &TypedOngoingStub[*Func0R2Stub[R1, R2]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func0R2Stub[R1, R2] {
+			return &Func0R2Stub[R1, R2]{stub: stub}
+		},
+	}
+}
@@ -0,0 +1,39 @@
+package mockit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc0R2() (int, error) {
+	return 1, nil
+}
+
+func Test_Func0R2_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func0R2[int, error](t, typedFunc0R2)
+	err := errors.New("some-error")
+	m.With().Return(42, err).Then().CallRealMethod()
+
+	got, gotErr := typedFunc0R2()
+	assert.Equal(t, 42, got)
+	assert.Equal(t, err, gotErr)
+	got, gotErr = typedFunc0R2()
+	assert.Equal(t, 1, got)
+	assert.Nil(t, gotErr)
+	m.VerifyTimes(2)
+}
+
+func Test_Func0R2_ShouldCallTheCallback(t *testing.T) {
+	m := Func0R2(t, typedFunc0R2)
+	m.With().DoAndReturn(func() (int, error) {
+		return 10, nil
+	})
+
+	got, err := typedFunc0R2()
+	assert.Equal(t, 10, got)
+	assert.Nil(t, err)
+	m.Verify()
+}
@@ -0,0 +1,32 @@
+package mockit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc0() string {
+	return fmt.Sprint("real")
+}
+
+func Test_Func0_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func0[string](t, typedFunc0)
+	m.With().Return("mocked").Then().CallRealMethod()
+
+	assert.Equal(t, "mocked", typedFunc0())
+	assert.Equal(t, fmt.Sprint("real"), typedFunc0())
+	m.VerifyTimes(2)
+}
+
+func Test_Func0_ShouldCallTheCallback(t *testing.T) {
+	m := Func0(t, typedFunc0)
+	m.With().DoAndReturn(func() string {
+		return fmt.Sprint("callback")
+	})
+
+	assert.Equal(t, fmt.Sprint("callback"), typedFunc0())
+	m.Verify()
+}
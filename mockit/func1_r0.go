@@ -0,0 +1,201 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func1R0 creates a new type-safe Mock of a function with one argument and no
+// results
+func Func1R0[A any](t *testing.T, fn func(A), options ...Option) *Func1R0Mock[A] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func1R0Mock[A]{mock: mock}
+}
+
+// Func1R0Mock is a type-safe Mock of a function with one argument and no
+// results
+type Func1R0Mock[A any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func1R0Mock[A]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func1R0Mock[A]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func1R0Mock[A]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func1R0Mock[A]) Remove(stub *Func1R0Stub[A]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func1R0Mock[A]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func1R0Mock[A]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func1R0Mock[A]) Verify(a A) {
+	m.mock.Verify(a)
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func1R0Mock[A]) VerifyAtLeast(times int, a A) {
+	m.mock.VerifyAtLeast(times, a)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func1R0Mock[A]) VerifyAtMost(times int, a A) {
+	m.mock.VerifyAtMost(times, a)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func1R0Mock[A]) VerifyBetween(min int, max int, a A) {
+	m.mock.VerifyBetween(min, max, a)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func1R0Mock[A]) VerifyNever(a A) {
+	m.mock.VerifyNever(a)
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func1R0Mock[A]) VerifyTimes(times int, a A) {
+	m.mock.VerifyTimes(times, a)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func1R0Mock[A]) VerifyWithin(timeout time.Duration, a A) {
+	m.mock.VerifyWithin(timeout, a)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func1R0Mock[A]) WaitForCall(ctx context.Context, a A) <-chan error {
+	return m.mock.WaitForCall(ctx, a)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func1R0Mock[A]) With(a A) *Func1R0Stub[A] {
+	return &Func1R0Stub[A]{stub: m.mock.With(a)}
+}
+
+// WithMatchers configures the mock to respond to the arguments satisfying the
+// specified matchers (or equal to the specified values)
+func (m *Func1R0Mock[A]) WithMatchers(a interface{}) *Func1R0Stub[A] {
+	return &Func1R0Stub[A]{stub: m.mock.With(a)}
+}
+
+// Func1R0Stub is a type-safe Stub of a function with one argument and no
+// results
+type Func1R0Stub[A any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func1R0Stub[A]) BlockUntil(ch <-chan struct{}) *Func1R0Stub[A] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func1R0Stub[A]) CallRealMethod() *TypedOngoingStub[*Func1R0Stub[A]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func1R0Stub[A]) Delay(d time.Duration) *Func1R0Stub[A] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func1R0Stub[A]) DoAndReturn(fn func(A)) *TypedOngoingStub[*Func1R0Stub[A]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func1R0Stub[A]) Panic(value interface{}) *TypedOngoingStub[*Func1R0Stub[A]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func1R0Stub[A]) PanicWithError(err error) *TypedOngoingStub[*Func1R0Stub[A]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func1R0Stub[A]) Return() *TypedOngoingStub[*Func1R0Stub[A]] {
+	return s.ongoing(s.stub.Return())
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func1R0Stub[A]) ReturnDefaults() *TypedOngoingStub[*Func1R0Stub[A]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// SetArg makes sure the mock to write the specified value through the
+// argument at the specified index (a pointer, a slice or a map), before
+// computing the outputs
+func (s *Func1R0Stub[A]) SetArg(index int, value interface{}) *Func1R0Stub[A] {
+	s.stub.SetArg(index, value)
+	return s
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func1R0Stub[A]) Times(times int) *Func1R0Stub[A] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func1R0Stub[A]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func1R0Stub[A]] {
+	return &TypedOngoingStub[*Func1R0Stub[A]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func1R0Stub[A] {
+			return &Func1R0Stub[A]{stub: stub}
+		},
+	}
+}
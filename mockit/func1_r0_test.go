@@ -0,0 +1,41 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+var typedFunc1R0Calls int
+
+//go:noinline
+func typedFunc1R0(a string) {
+	typedFunc1R0Calls++
+}
+
+func Test_Func1R0_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func1R0[string](t, typedFunc1R0)
+	m.With("some-argument").Return().Then().CallRealMethod()
+
+	typedFunc1R0("some-argument")
+	assert.Equal(t, 0, typedFunc1R0Calls)
+	typedFunc1R0("some-argument")
+	assert.Equal(t, 1, typedFunc1R0Calls)
+	m.VerifyTimes(2, "some-argument")
+	typedFunc1R0Calls = 0
+	m.VerifyNever("other-argument")
+}
+
+func Test_Func1R0_ShouldCallTheCallback(t *testing.T) {
+	m := Func1R0(t, typedFunc1R0)
+	m.WithMatchers(argument.Any).DoAndReturn(func(a string) {
+		typedFunc1R0Calls += 10
+	})
+
+	typedFunc1R0("other-argument")
+	assert.Equal(t, 10, typedFunc1R0Calls)
+	typedFunc1R0Calls = 0
+	m.Verify("other-argument")
+	m.Mock().Verify(argument.Any)
+}
@@ -0,0 +1,42 @@
+package mockit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc1R2(a string) (int, error) {
+	return 2, nil
+}
+
+func Test_Func1R2_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func1R2[string, int, error](t, typedFunc1R2)
+	err := errors.New("some-error")
+	m.With("some-argument").Return(42, err).Then().CallRealMethod()
+
+	got, gotErr := typedFunc1R2("some-argument")
+	assert.Equal(t, 42, got)
+	assert.Equal(t, err, gotErr)
+	got, gotErr = typedFunc1R2("some-argument")
+	assert.Equal(t, 2, got)
+	assert.Nil(t, gotErr)
+	m.VerifyTimes(2, "some-argument")
+	m.VerifyNever("other-argument")
+}
+
+func Test_Func1R2_ShouldCallTheCallback(t *testing.T) {
+	m := Func1R2(t, typedFunc1R2)
+	m.WithMatchers(argument.Any).DoAndReturn(func(a string) (int, error) {
+		return 10, nil
+	})
+
+	got, err := typedFunc1R2("other-argument")
+	assert.Equal(t, 10, got)
+	assert.Nil(t, err)
+	m.Verify("other-argument")
+	m.Mock().Verify(argument.Any)
+}
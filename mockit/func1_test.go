@@ -0,0 +1,73 @@
+package mockit
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc1(a string) string {
+	return fmt.Sprint("real", a)
+}
+
+func Test_Func1_Example_ShouldReturnExpectedValueForTheReadmeExample(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := Func1(t, filepath.Base)
+	m.With("some-argument").Return("result")
+	assert.Equal(t, "result", filepath.Base("some-argument"))
+}
+
+func Test_Func1_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func1[string, string](t, typedFunc1)
+	m.With("some-argument").Return("mocked").Then().CallRealMethod()
+
+	assert.Equal(t, "mocked", typedFunc1("some-argument"))
+	assert.Equal(t, fmt.Sprint("real", "some-argument"), typedFunc1("some-argument"))
+	m.VerifyTimes(2, "some-argument")
+	m.VerifyNever("other-argument")
+}
+
+func Test_Func1_ShouldCallTheCallback(t *testing.T) {
+	m := Func1(t, typedFunc1)
+	m.WithMatchers(argument.Any).DoAndReturn(func(a string) string {
+		return fmt.Sprint("callback", a)
+	})
+
+	assert.Equal(t, fmt.Sprint("callback", "other-argument"), typedFunc1("other-argument"))
+	m.Verify("other-argument")
+	m.Mock().Verify(argument.Any)
+}
+
+func Test_Func1_ShouldWaitForTheCall(t *testing.T) {
+	m := Func1(t, typedFunc1)
+	m.With("some-argument").Return("mocked")
+
+	result := m.WaitForCall(context.Background(), "some-argument")
+	go typedFunc1("some-argument")
+
+	assert.Nil(t, <-result)
+	m.VerifyWithin(time.Second, "some-argument")
+}
+
+func Test_Func1_ShouldRemoveTheStub(t *testing.T) {
+	m := Func1(t, typedFunc1)
+	stub := m.With("some-argument")
+	stub.Return("some-value")
+	m.With("other-argument").Return("other-value")
+
+	m.Remove(stub)
+	typedFunc1("some-argument")
+	m.ResetCalls()
+
+	assert.Equal(t, "", typedFunc1("some-argument"))
+	assert.Equal(t, "other-value", typedFunc1("other-argument"))
+	m.VerifyTimes(1, "some-argument")
+	m.Reset()
+	m.VerifyNever("some-argument")
+}
@@ -0,0 +1,219 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func2 creates a new type-safe Mock of a function with two arguments and one
+// result
+func Func2[A, B, R any](t *testing.T, fn func(A, B) R, options ...Option) *Func2Mock[A, B, R] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func2Mock[A, B, R]{mock: mock}
+}
+
+// Func2Mock is a type-safe Mock of a function with two arguments and one result
+type Func2Mock[A, B, R any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func2Mock[A, B, R]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func2Mock[A, B, R]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func2Mock[A, B, R]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func2Mock[A, B, R]) Remove(stub *Func2Stub[A, B, R]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func2Mock[A, B, R]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func2Mock[A, B, R]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func2Mock[A, B, R]) Verify(a A, b B) {
+	m.mock.Verify(a, b)
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func2Mock[A, B, R]) VerifyAtLeast(times int, a A, b B) {
+	m.mock.VerifyAtLeast(times, a, b)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func2Mock[A, B, R]) VerifyAtMost(times int, a A, b B) {
+	m.mock.VerifyAtMost(times, a, b)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func2Mock[A, B, R]) VerifyBetween(min int, max int, a A, b B) {
+	m.mock.VerifyBetween(min, max, a, b)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func2Mock[A, B, R]) VerifyNever(a A, b B) {
+	m.mock.VerifyNever(a, b)
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func2Mock[A, B, R]) VerifyTimes(times int, a A, b B) {
+	m.mock.VerifyTimes(times, a, b)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func2Mock[A, B, R]) VerifyWithin(timeout time.Duration, a A, b B) {
+	m.mock.VerifyWithin(timeout, a, b)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func2Mock[A, B, R]) WaitForCall(ctx context.Context, a A, b B) <-chan error {
+	return m.mock.WaitForCall(ctx, a, b)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func2Mock[A, B, R]) With(a A, b B) *Func2Stub[A, B, R] {
+	return &Func2Stub[A, B, R]{stub: m.mock.With(a, b)}
+}
+
+// WithMatchers configures the mock to respond to the arguments satisfying the
+// specified matchers (or equal to the specified values)
+func (m *Func2Mock[A, B, R]) WithMatchers(a, b interface{}) *Func2Stub[A, B, R] {
+	return &Func2Stub[A, B, R]{stub: m.mock.With(a, b)}
+}
+
+// Func2Stub is a type-safe Stub of a function with two arguments and one result
+type Func2Stub[A, B, R any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func2Stub[A, B, R]) BlockUntil(ch <-chan struct{}) *Func2Stub[A, B, R] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// BlockUntilContext is like BlockUntil, but if the context (that must be the
+// first argument) is done before, the mock returns the context error
+func (s *Func2Stub[A, B, R]) BlockUntilContext(ch <-chan struct{}) *Func2Stub[A, B, R] {
+	s.stub.BlockUntilContext(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func2Stub[A, B, R]) CallRealMethod() *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func2Stub[A, B, R]) Delay(d time.Duration) *Func2Stub[A, B, R] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DelayContext is like Delay, but if the context (that must be the first
+// argument) is done before, the mock returns the context error
+func (s *Func2Stub[A, B, R]) DelayContext(d time.Duration) *Func2Stub[A, B, R] {
+	s.stub.DelayContext(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func2Stub[A, B, R]) DoAndReturn(fn func(A, B) R) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func2Stub[A, B, R]) Panic(value interface{}) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func2Stub[A, B, R]) PanicWithError(err error) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func2Stub[A, B, R]) Return(r R) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.Return(r))
+}
+
+// ReturnArg makes sure the mock to return the argument at the specified
+// index as first output, and the zero values as the others
+func (s *Func2Stub[A, B, R]) ReturnArg(index int) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.ReturnArg(index))
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func2Stub[A, B, R]) ReturnDefaults() *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// SetArg makes sure the mock to write the specified value through the
+// argument at the specified index (a pointer, a slice or a map), before
+// computing the outputs
+func (s *Func2Stub[A, B, R]) SetArg(index int, value interface{}) *Func2Stub[A, B, R] {
+	s.stub.SetArg(index, value)
+	return s
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func2Stub[A, B, R]) Times(times int) *Func2Stub[A, B, R] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func2Stub[A, B, R]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func2Stub[A, B, R]] {
+	return &TypedOngoingStub[*Func2Stub[A, B, R]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func2Stub[A, B, R] {
+			return &Func2Stub[A, B, R]{stub: stub}
+		},
+	}
+}
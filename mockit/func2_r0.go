@@ -0,0 +1,201 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func2R0 creates a new type-safe Mock of a function with two arguments and no
+// results
+func Func2R0[A, B any](t *testing.T, fn func(A, B), options ...Option) *Func2R0Mock[A, B] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func2R0Mock[A, B]{mock: mock}
+}
+
+// Func2R0Mock is a type-safe Mock of a function with two arguments and no
+// results
+type Func2R0Mock[A, B any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func2R0Mock[A, B]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func2R0Mock[A, B]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func2R0Mock[A, B]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func2R0Mock[A, B]) Remove(stub *Func2R0Stub[A, B]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func2R0Mock[A, B]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func2R0Mock[A, B]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func2R0Mock[A, B]) Verify(a A, b B) {
+	m.mock.Verify(a, b)
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func2R0Mock[A, B]) VerifyAtLeast(times int, a A, b B) {
+	m.mock.VerifyAtLeast(times, a, b)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func2R0Mock[A, B]) VerifyAtMost(times int, a A, b B) {
+	m.mock.VerifyAtMost(times, a, b)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func2R0Mock[A, B]) VerifyBetween(min int, max int, a A, b B) {
+	m.mock.VerifyBetween(min, max, a, b)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func2R0Mock[A, B]) VerifyNever(a A, b B) {
+	m.mock.VerifyNever(a, b)
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func2R0Mock[A, B]) VerifyTimes(times int, a A, b B) {
+	m.mock.VerifyTimes(times, a, b)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func2R0Mock[A, B]) VerifyWithin(timeout time.Duration, a A, b B) {
+	m.mock.VerifyWithin(timeout, a, b)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func2R0Mock[A, B]) WaitForCall(ctx context.Context, a A, b B) <-chan error {
+	return m.mock.WaitForCall(ctx, a, b)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func2R0Mock[A, B]) With(a A, b B) *Func2R0Stub[A, B] {
+	return &Func2R0Stub[A, B]{stub: m.mock.With(a, b)}
+}
+
+// WithMatchers configures the mock to respond to the arguments satisfying the
+// specified matchers (or equal to the specified values)
+func (m *Func2R0Mock[A, B]) WithMatchers(a, b interface{}) *Func2R0Stub[A, B] {
+	return &Func2R0Stub[A, B]{stub: m.mock.With(a, b)}
+}
+
+// Func2R0Stub is a type-safe Stub of a function with two arguments and no
+// results
+type Func2R0Stub[A, B any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func2R0Stub[A, B]) BlockUntil(ch <-chan struct{}) *Func2R0Stub[A, B] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func2R0Stub[A, B]) CallRealMethod() *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func2R0Stub[A, B]) Delay(d time.Duration) *Func2R0Stub[A, B] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func2R0Stub[A, B]) DoAndReturn(fn func(A, B)) *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func2R0Stub[A, B]) Panic(value interface{}) *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func2R0Stub[A, B]) PanicWithError(err error) *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func2R0Stub[A, B]) Return() *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return s.ongoing(s.stub.Return())
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func2R0Stub[A, B]) ReturnDefaults() *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// SetArg makes sure the mock to write the specified value through the
+// argument at the specified index (a pointer, a slice or a map), before
+// computing the outputs
+func (s *Func2R0Stub[A, B]) SetArg(index int, value interface{}) *Func2R0Stub[A, B] {
+	s.stub.SetArg(index, value)
+	return s
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func2R0Stub[A, B]) Times(times int) *Func2R0Stub[A, B] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func2R0Stub[A, B]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func2R0Stub[A, B]] {
+	return &TypedOngoingStub[*Func2R0Stub[A, B]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func2R0Stub[A, B] {
+			return &Func2R0Stub[A, B]{stub: stub}
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+var typedFunc2R0Calls int
+
+//go:noinline
+func typedFunc2R0(a string, b int) {
+	typedFunc2R0Calls++
+}
+
+func Test_Func2R0_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func2R0[string, int](t, typedFunc2R0)
+	m.With("some-argument", 3).Return().Then().CallRealMethod()
+
+	typedFunc2R0("some-argument", 3)
+	assert.Equal(t, 0, typedFunc2R0Calls)
+	typedFunc2R0("some-argument", 3)
+	assert.Equal(t, 1, typedFunc2R0Calls)
+	m.VerifyTimes(2, "some-argument", 3)
+	typedFunc2R0Calls = 0
+	m.VerifyNever("other-argument", 4)
+}
+
+func Test_Func2R0_ShouldCallTheCallback(t *testing.T) {
+	m := Func2R0(t, typedFunc2R0)
+	m.WithMatchers(argument.Any, argument.Any).DoAndReturn(func(a string, b int) {
+		typedFunc2R0Calls += 10
+	})
+
+	typedFunc2R0("other-argument", 4)
+	assert.Equal(t, 10, typedFunc2R0Calls)
+	typedFunc2R0Calls = 0
+	m.Verify("other-argument", 4)
+	m.Mock().Verify(argument.Any, argument.Any)
+}
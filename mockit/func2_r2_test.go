@@ -0,0 +1,74 @@
+package mockit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc2R2(a string, b int) (int, error) {
+	return 3, nil
+}
+
+func Test_Func2R2_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func2R2[string, int, int, error](t, typedFunc2R2)
+	err := errors.New("some-error")
+	m.With("some-argument", 3).Return(42, err).Then().CallRealMethod()
+
+	got, gotErr := typedFunc2R2("some-argument", 3)
+	assert.Equal(t, 42, got)
+	assert.Equal(t, err, gotErr)
+	got, gotErr = typedFunc2R2("some-argument", 3)
+	assert.Equal(t, 3, got)
+	assert.Nil(t, gotErr)
+	m.VerifyTimes(2, "some-argument", 3)
+	m.VerifyNever("other-argument", 4)
+}
+
+func Test_Func2R2_ShouldCallTheCallback(t *testing.T) {
+	m := Func2R2(t, typedFunc2R2)
+	m.WithMatchers(argument.Any, argument.Any).DoAndReturn(func(a string, b int) (int, error) {
+		return 10, nil
+	})
+
+	got, err := typedFunc2R2("other-argument", 4)
+	assert.Equal(t, 10, got)
+	assert.Nil(t, err)
+	m.Verify("other-argument", 4)
+	m.Mock().Verify(argument.Any, argument.Any)
+}
+
+func Test_Func2R2_ShouldPanicWithTheError(t *testing.T) {
+	m := Func2R2(t, typedFunc2R2)
+	err := errors.New("some-error")
+	m.With("some-argument", 3).PanicWithError(err)
+
+	assert.PanicsWithError(t, "some-error", func() {
+		typedFunc2R2("some-argument", 3)
+	})
+	m.Verify("some-argument", 3)
+}
+
+func Test_Func2R2_ShouldReturnTheArgument(t *testing.T) {
+	m := Func2R2(t, typedFunc2R2)
+	m.With("some-argument", 3).ReturnArg(1)
+
+	got, err := typedFunc2R2("some-argument", 3)
+	assert.Equal(t, 3, got)
+	assert.Nil(t, err)
+}
+
+func Test_Func2R2_ShouldReturnTheContextError(t *testing.T) {
+	m := Func2R2(t, mockFuncTestFetch)
+	m.WithMatchers(argument.Any, "some-id").BlockUntilContext(make(chan struct{})).Return("some-value", nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err := mockFuncTestFetch(ctx, "some-id")
+	assert.Equal(t, "", got)
+	assert.Equal(t, context.Canceled, err)
+}
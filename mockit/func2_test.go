@@ -0,0 +1,35 @@
+package mockit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc2(a string, b int) string {
+	return fmt.Sprint("real", a, b)
+}
+
+func Test_Func2_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func2[string, int, string](t, typedFunc2)
+	m.With("some-argument", 3).Return("mocked").Then().CallRealMethod()
+
+	assert.Equal(t, "mocked", typedFunc2("some-argument", 3))
+	assert.Equal(t, fmt.Sprint("real", "some-argument", 3), typedFunc2("some-argument", 3))
+	m.VerifyTimes(2, "some-argument", 3)
+	m.VerifyNever("other-argument", 4)
+}
+
+func Test_Func2_ShouldCallTheCallback(t *testing.T) {
+	m := Func2(t, typedFunc2)
+	m.WithMatchers(argument.Any, argument.Any).DoAndReturn(func(a string, b int) string {
+		return fmt.Sprint("callback", a, b)
+	})
+
+	assert.Equal(t, fmt.Sprint("callback", "other-argument", 4), typedFunc2("other-argument", 4))
+	m.Verify("other-argument", 4)
+	m.Mock().Verify(argument.Any, argument.Any)
+}
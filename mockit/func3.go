@@ -0,0 +1,221 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func3 creates a new type-safe Mock of a function with three arguments and one
+// result
+func Func3[A, B, C, R any](t *testing.T, fn func(A, B, C) R, options ...Option) *Func3Mock[A, B, C, R] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func3Mock[A, B, C, R]{mock: mock}
+}
+
+// Func3Mock is a type-safe Mock of a function with three arguments and one
+// result
+type Func3Mock[A, B, C, R any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func3Mock[A, B, C, R]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func3Mock[A, B, C, R]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func3Mock[A, B, C, R]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func3Mock[A, B, C, R]) Remove(stub *Func3Stub[A, B, C, R]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func3Mock[A, B, C, R]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func3Mock[A, B, C, R]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func3Mock[A, B, C, R]) Verify(a A, b B, c C) {
+	m.mock.Verify(a, b, c)
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func3Mock[A, B, C, R]) VerifyAtLeast(times int, a A, b B, c C) {
+	m.mock.VerifyAtLeast(times, a, b, c)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func3Mock[A, B, C, R]) VerifyAtMost(times int, a A, b B, c C) {
+	m.mock.VerifyAtMost(times, a, b, c)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func3Mock[A, B, C, R]) VerifyBetween(min int, max int, a A, b B, c C) {
+	m.mock.VerifyBetween(min, max, a, b, c)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func3Mock[A, B, C, R]) VerifyNever(a A, b B, c C) {
+	m.mock.VerifyNever(a, b, c)
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func3Mock[A, B, C, R]) VerifyTimes(times int, a A, b B, c C) {
+	m.mock.VerifyTimes(times, a, b, c)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func3Mock[A, B, C, R]) VerifyWithin(timeout time.Duration, a A, b B, c C) {
+	m.mock.VerifyWithin(timeout, a, b, c)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func3Mock[A, B, C, R]) WaitForCall(ctx context.Context, a A, b B, c C) <-chan error {
+	return m.mock.WaitForCall(ctx, a, b, c)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func3Mock[A, B, C, R]) With(a A, b B, c C) *Func3Stub[A, B, C, R] {
+	return &Func3Stub[A, B, C, R]{stub: m.mock.With(a, b, c)}
+}
+
+// WithMatchers configures the mock to respond to the arguments satisfying the
+// specified matchers (or equal to the specified values)
+func (m *Func3Mock[A, B, C, R]) WithMatchers(a, b, c interface{}) *Func3Stub[A, B, C, R] {
+	return &Func3Stub[A, B, C, R]{stub: m.mock.With(a, b, c)}
+}
+
+// Func3Stub is a type-safe Stub of a function with three arguments and one
+// result
+type Func3Stub[A, B, C, R any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func3Stub[A, B, C, R]) BlockUntil(ch <-chan struct{}) *Func3Stub[A, B, C, R] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// BlockUntilContext is like BlockUntil, but if the context (that must be the
+// first argument) is done before, the mock returns the context error
+func (s *Func3Stub[A, B, C, R]) BlockUntilContext(ch <-chan struct{}) *Func3Stub[A, B, C, R] {
+	s.stub.BlockUntilContext(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func3Stub[A, B, C, R]) CallRealMethod() *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func3Stub[A, B, C, R]) Delay(d time.Duration) *Func3Stub[A, B, C, R] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DelayContext is like Delay, but if the context (that must be the first
+// argument) is done before, the mock returns the context error
+func (s *Func3Stub[A, B, C, R]) DelayContext(d time.Duration) *Func3Stub[A, B, C, R] {
+	s.stub.DelayContext(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func3Stub[A, B, C, R]) DoAndReturn(fn func(A, B, C) R) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func3Stub[A, B, C, R]) Panic(value interface{}) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func3Stub[A, B, C, R]) PanicWithError(err error) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func3Stub[A, B, C, R]) Return(r R) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.Return(r))
+}
+
+// ReturnArg makes sure the mock to return the argument at the specified
+// index as first output, and the zero values as the others
+func (s *Func3Stub[A, B, C, R]) ReturnArg(index int) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.ReturnArg(index))
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func3Stub[A, B, C, R]) ReturnDefaults() *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// SetArg makes sure the mock to write the specified value through the
+// argument at the specified index (a pointer, a slice or a map), before
+// computing the outputs
+func (s *Func3Stub[A, B, C, R]) SetArg(index int, value interface{}) *Func3Stub[A, B, C, R] {
+	s.stub.SetArg(index, value)
+	return s
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func3Stub[A, B, C, R]) Times(times int) *Func3Stub[A, B, C, R] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func3Stub[A, B, C, R]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func3Stub[A, B, C, R]] {
+	return &TypedOngoingStub[*Func3Stub[A, B, C, R]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func3Stub[A, B, C, R] {
+			return &Func3Stub[A, B, C, R]{stub: stub}
+		},
+	}
+}
@@ -0,0 +1,41 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+var typedFunc3R0Calls int
+
+//go:noinline
+func typedFunc3R0(a string, b int, c bool) {
+	typedFunc3R0Calls++
+}
+
+func Test_Func3R0_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func3R0[string, int, bool](t, typedFunc3R0)
+	m.With("some-argument", 3, true).Return().Then().CallRealMethod()
+
+	typedFunc3R0("some-argument", 3, true)
+	assert.Equal(t, 0, typedFunc3R0Calls)
+	typedFunc3R0("some-argument", 3, true)
+	assert.Equal(t, 1, typedFunc3R0Calls)
+	m.VerifyTimes(2, "some-argument", 3, true)
+	typedFunc3R0Calls = 0
+	m.VerifyNever("other-argument", 4, false)
+}
+
+func Test_Func3R0_ShouldCallTheCallback(t *testing.T) {
+	m := Func3R0(t, typedFunc3R0)
+	m.WithMatchers(argument.Any, argument.Any, argument.Any).DoAndReturn(func(a string, b int, c bool) {
+		typedFunc3R0Calls += 10
+	})
+
+	typedFunc3R0("other-argument", 4, false)
+	assert.Equal(t, 10, typedFunc3R0Calls)
+	typedFunc3R0Calls = 0
+	m.Verify("other-argument", 4, false)
+	m.Mock().Verify(argument.Any, argument.Any, argument.Any)
+}
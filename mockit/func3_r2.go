@@ -0,0 +1,221 @@
+// Code generated by gen_funcs.go; DO NOT EDIT.
+
+package mockit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+// Func3R2 creates a new type-safe Mock of a function with three arguments and
+// two results
+func Func3R2[A, B, C, R1, R2 any](t *testing.T, fn func(A, B, C) (R1, R2), options ...Option) *Func3R2Mock[A, B, C, R1, R2] {
+	mock := manager.MockFunc(t, fn, options...)
+	if mock == nil {
+		return nil
+	}
+	return &Func3R2Mock[A, B, C, R1, R2]{mock: mock}
+}
+
+// Func3R2Mock is a type-safe Mock of a function with three arguments and two
+// results
+type Func3R2Mock[A, B, C, R1, R2 any] struct {
+	mock Mock
+}
+
+// Disable disable the mock, so interactions will be with real objects
+func (m *Func3R2Mock[A, B, C, R1, R2]) Disable() {
+	m.mock.Disable()
+}
+
+// Enable restore the mock
+func (m *Func3R2Mock[A, B, C, R1, R2]) Enable() {
+	m.mock.Enable()
+}
+
+// Mock returns the underlying Mock, i.e. to verify calls using argument
+// matchers or in order
+func (m *Func3R2Mock[A, B, C, R1, R2]) Mock() Mock {
+	return m.mock
+}
+
+// Remove deletes the specified stub, that must have been configured on this
+// mock
+func (m *Func3R2Mock[A, B, C, R1, R2]) Remove(stub *Func3R2Stub[A, B, C, R1, R2]) {
+	if stub == nil {
+		m.mock.Remove(nil)
+		return
+	}
+	m.mock.Remove(stub.stub)
+}
+
+// Reset deletes all the stubs and the recorded calls
+func (m *Func3R2Mock[A, B, C, R1, R2]) Reset() {
+	m.mock.Reset()
+}
+
+// ResetCalls deletes the recorded calls, so they are not considered by the
+// verifications anymore
+func (m *Func3R2Mock[A, B, C, R1, R2]) ResetCalls() {
+	m.mock.ResetCalls()
+}
+
+// Verify fails the test if a call with the specified arguments wasn't made
+func (m *Func3R2Mock[A, B, C, R1, R2]) Verify(a A, b B, c C) {
+	m.mock.Verify(a, b, c)
+}
+
+// VerifyAtLeast fails the test if a call with the specified arguments wasn't
+// made at least the specified number of times
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyAtLeast(times int, a A, b B, c C) {
+	m.mock.VerifyAtLeast(times, a, b, c)
+}
+
+// VerifyAtMost fails the test if a call with the specified arguments was made
+// more than the specified number of times
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyAtMost(times int, a A, b B, c C) {
+	m.mock.VerifyAtMost(times, a, b, c)
+}
+
+// VerifyBetween fails the test if the number of calls with the specified
+// arguments is not in the range [min, max]
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyBetween(min int, max int, a A, b B, c C) {
+	m.mock.VerifyBetween(min, max, a, b, c)
+}
+
+// VerifyNever fails the test if a call with the specified arguments was made
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyNever(a A, b B, c C) {
+	m.mock.VerifyNever(a, b, c)
+}
+
+// VerifyTimes fails the test if a call with the specified arguments wasn't
+// made exactly the specified number of times
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyTimes(times int, a A, b B, c C) {
+	m.mock.VerifyTimes(times, a, b, c)
+}
+
+// VerifyWithin waits for a call with the specified arguments, and fails the
+// test if it isn't made within the timeout
+func (m *Func3R2Mock[A, B, C, R1, R2]) VerifyWithin(timeout time.Duration, a A, b B, c C) {
+	m.mock.VerifyWithin(timeout, a, b, c)
+}
+
+// WaitForCall returns a channel that receives nil once a call with the
+// specified arguments is made, or the context error if it is done before
+func (m *Func3R2Mock[A, B, C, R1, R2]) WaitForCall(ctx context.Context, a A, b B, c C) <-chan error {
+	return m.mock.WaitForCall(ctx, a, b, c)
+}
+
+// With configures the mock to respond to the specified arguments
+func (m *Func3R2Mock[A, B, C, R1, R2]) With(a A, b B, c C) *Func3R2Stub[A, B, C, R1, R2] {
+	return &Func3R2Stub[A, B, C, R1, R2]{stub: m.mock.With(a, b, c)}
+}
+
+// WithMatchers configures the mock to respond to the arguments satisfying the
+// specified matchers (or equal to the specified values)
+func (m *Func3R2Mock[A, B, C, R1, R2]) WithMatchers(a, b, c interface{}) *Func3R2Stub[A, B, C, R1, R2] {
+	return &Func3R2Stub[A, B, C, R1, R2]{stub: m.mock.With(a, b, c)}
+}
+
+// Func3R2Stub is a type-safe Stub of a function with three arguments and two
+// results
+type Func3R2Stub[A, B, C, R1, R2 any] struct {
+	stub Stub
+}
+
+// BlockUntil makes sure the mock to block the call until the channel is
+// closed (or receives a value), before computing the outputs
+func (s *Func3R2Stub[A, B, C, R1, R2]) BlockUntil(ch <-chan struct{}) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.BlockUntil(ch)
+	return s
+}
+
+// BlockUntilContext is like BlockUntil, but if the context (that must be the
+// first argument) is done before, the mock returns the context error
+func (s *Func3R2Stub[A, B, C, R1, R2]) BlockUntilContext(ch <-chan struct{}) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.BlockUntilContext(ch)
+	return s
+}
+
+// CallRealMethod makes sure that the mock perform a call to the real method
+func (s *Func3R2Stub[A, B, C, R1, R2]) CallRealMethod() *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.CallRealMethod())
+}
+
+// Delay makes sure the mock to sleep for the specified duration, before
+// computing the outputs
+func (s *Func3R2Stub[A, B, C, R1, R2]) Delay(d time.Duration) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.Delay(d)
+	return s
+}
+
+// DelayContext is like Delay, but if the context (that must be the first
+// argument) is done before, the mock returns the context error
+func (s *Func3R2Stub[A, B, C, R1, R2]) DelayContext(d time.Duration) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.DelayContext(d)
+	return s
+}
+
+// DoAndReturn makes sure the mock to call the specified function, with the
+// actual arguments, and return its outputs
+func (s *Func3R2Stub[A, B, C, R1, R2]) DoAndReturn(fn func(A, B, C) (R1, R2)) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	if fn == nil {
+		return s.ongoing(s.stub.DoAndReturn(nil))
+	}
+	return s.ongoing(s.stub.DoAndReturn(fn))
+}
+
+// Panic makes sure the mock to panic with the specified value; the call is
+// recorded anyway
+func (s *Func3R2Stub[A, B, C, R1, R2]) Panic(value interface{}) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.Panic(value))
+}
+
+// PanicWithError makes sure the mock to panic with the specified error; the
+// call is recorded anyway
+func (s *Func3R2Stub[A, B, C, R1, R2]) PanicWithError(err error) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.PanicWithError(err))
+}
+
+// Return makes sure the mock to return the specified values
+func (s *Func3R2Stub[A, B, C, R1, R2]) Return(r1 R1, r2 R2) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.Return(r1, r2))
+}
+
+// ReturnArg makes sure the mock to return the argument at the specified
+// index as first output, and the zero values as the others
+func (s *Func3R2Stub[A, B, C, R1, R2]) ReturnArg(index int) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.ReturnArg(index))
+}
+
+// ReturnDefaults makes sure the mock to return the default outputs (zero
+// values)
+func (s *Func3R2Stub[A, B, C, R1, R2]) ReturnDefaults() *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return s.ongoing(s.stub.ReturnDefaults())
+}
+
+// SetArg makes sure the mock to write the specified value through the
+// argument at the specified index (a pointer, a slice or a map), before
+// computing the outputs
+func (s *Func3R2Stub[A, B, C, R1, R2]) SetArg(index int, value interface{}) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.SetArg(index, value)
+	return s
+}
+
+// Times declares that the stub is expected to be used exactly the specified
+// number of times, the expectation is automatically verified at the end of
+// the test
+func (s *Func3R2Stub[A, B, C, R1, R2]) Times(times int) *Func3R2Stub[A, B, C, R1, R2] {
+	s.stub.Times(times)
+	return s
+}
+
+func (s *Func3R2Stub[A, B, C, R1, R2]) ongoing(ongoing OngoingStub) *TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]] {
+	return &TypedOngoingStub[*Func3R2Stub[A, B, C, R1, R2]]{
+		ongoing: ongoing,
+		wrap: func(stub Stub) *Func3R2Stub[A, B, C, R1, R2] {
+			return &Func3R2Stub[A, B, C, R1, R2]{stub: stub}
+		},
+	}
+}
@@ -0,0 +1,54 @@
+package mockit
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc3R2(a string, b int, c bool) (int, error) {
+	return 4, nil
+}
+
+func Test_Func3R2_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func3R2[string, int, bool, int, error](t, typedFunc3R2)
+	err := errors.New("some-error")
+	m.With("some-argument", 3, true).Return(42, err).Then().CallRealMethod()
+
+	got, gotErr := typedFunc3R2("some-argument", 3, true)
+	assert.Equal(t, 42, got)
+	assert.Equal(t, err, gotErr)
+	got, gotErr = typedFunc3R2("some-argument", 3, true)
+	assert.Equal(t, 4, got)
+	assert.Nil(t, gotErr)
+	m.VerifyTimes(2, "some-argument", 3, true)
+	m.VerifyNever("other-argument", 4, false)
+}
+
+func Test_Func3R2_ShouldCallTheCallback(t *testing.T) {
+	m := Func3R2(t, typedFunc3R2)
+	m.WithMatchers(argument.Any, argument.Any, argument.Any).DoAndReturn(func(a string, b int, c bool) (int, error) {
+		return 10, nil
+	})
+
+	got, err := typedFunc3R2("other-argument", 4, false)
+	assert.Equal(t, 10, got)
+	assert.Nil(t, err)
+	m.Verify("other-argument", 4, false)
+	m.Mock().Verify(argument.Any, argument.Any, argument.Any)
+}
+
+func Test_Func3R2_ShouldSetTheArguments(t *testing.T) {
+	m := Func3R2(t, io.ReadAtLeast)
+	m.WithMatchers(argument.Any, argument.Any, 2).SetArg(1, []byte("ab")).Return(2, nil)
+
+	buf := make([]byte, 2)
+	n, err := io.ReadAtLeast(nil, buf, 2)
+	assert.Equal(t, 2, n)
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("ab"), buf)
+}
@@ -0,0 +1,35 @@
+package mockit
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func typedFunc3(a string, b int, c bool) string {
+	return fmt.Sprint("real", a, b, c)
+}
+
+func Test_Func3_ShouldStubAndVerifyTheCalls(t *testing.T) {
+	m := Func3[string, int, bool, string](t, typedFunc3)
+	m.With("some-argument", 3, true).Return("mocked").Then().CallRealMethod()
+
+	assert.Equal(t, "mocked", typedFunc3("some-argument", 3, true))
+	assert.Equal(t, fmt.Sprint("real", "some-argument", 3, true), typedFunc3("some-argument", 3, true))
+	m.VerifyTimes(2, "some-argument", 3, true)
+	m.VerifyNever("other-argument", 4, false)
+}
+
+func Test_Func3_ShouldCallTheCallback(t *testing.T) {
+	m := Func3(t, typedFunc3)
+	m.WithMatchers(argument.Any, argument.Any, argument.Any).DoAndReturn(func(a string, b int, c bool) string {
+		return fmt.Sprint("callback", a, b, c)
+	})
+
+	assert.Equal(t, fmt.Sprint("callback", "other-argument", 4, false), typedFunc3("other-argument", 4, false))
+	m.Verify("other-argument", 4, false)
+	m.Mock().Verify(argument.Any, argument.Any, argument.Any)
+}
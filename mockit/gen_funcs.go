@@ -0,0 +1,145 @@
+//go:build ignore
+
+// gen_funcs generates the type-safe API (Func0, Func1R2, ...) from the
+// template in func.go.tmpl, one file for each number of arguments and results.
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"strings"
+	"text/template"
+)
+
+const (
+	maxArgs    = 3
+	maxResults = 2
+	// commentWidth is the maximum length of a line of a doc comment
+	commentWidth = 80
+)
+
+var (
+	argTypes    = []string{"A", "B", "C"}
+	argNames    = []string{"a", "b", "c"}
+	resultTypes = map[int][]string{0: {}, 1: {"R"}, 2: {"R1", "R2"}}
+	resultNames = map[int][]string{0: {}, 1: {"r"}, 2: {"r1", "r2"}}
+	numbers     = []string{"no", "one", "two", "three"}
+)
+
+const header = "// Code generated by gen_funcs.go; DO NOT EDIT.\n\n"
+
+// data contains the values used by the template
+type data struct {
+	Name         string
+	Description  string
+	TypeParams   string
+	FuncType     string
+	Mock         string
+	Stub         string
+	Ongoing      string
+	Params       string
+	Args         string
+	ResultParams string
+	Results      string
+}
+
+func main() {
+	tmpl := template.Must(template.ParseFiles("func.go.tmpl"))
+
+	for args := 0; args <= maxArgs; args++ {
+		for results := 0; results <= maxResults; results++ {
+			name, file := fmt.Sprintf("Func%d", args), fmt.Sprintf("func%d", args)
+			if results != 1 {
+				name += fmt.Sprintf("R%d", results)
+				file += fmt.Sprintf("_r%d", results)
+			}
+
+			var out bytes.Buffer
+			out.WriteString(header)
+			if err := tmpl.Execute(&out, newData(name, args, results)); err != nil {
+				log.Fatal(err)
+			}
+			source, err := format.Source(wrapComments(out.Bytes()))
+			if err != nil {
+				log.Fatal(err)
+			}
+			if err := os.WriteFile(file+".go", source, 0644); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+}
+
+func newData(name string, args, results int) *data {
+	typeNames := append(append([]string{}, argTypes[:args]...), resultTypes[results]...)
+	typeParams, typeArgs := "", ""
+	if len(typeNames) > 0 {
+		typeParams = "[" + strings.Join(typeNames, ", ") + " any]"
+		typeArgs = "[" + strings.Join(typeNames, ", ") + "]"
+	}
+
+	params := make([]string, args)
+	for i := range params {
+		params[i] = argNames[i] + " " + argTypes[i]
+	}
+	resultParams := make([]string, results)
+	for i := range resultParams {
+		resultParams[i] = resultNames[results][i] + " " + resultTypes[results][i]
+	}
+
+	funcType := "func(" + strings.Join(argTypes[:args], ", ") + ")"
+	switch results {
+	case 0:
+	case 1:
+		funcType += " " + resultTypes[results][0]
+	default:
+		funcType += " (" + strings.Join(resultTypes[results], ", ") + ")"
+	}
+
+	stub := name + "Stub" + typeArgs
+	return &data{
+		Name:         name,
+		Description:  fmt.Sprintf("a function with %s and %s", count(args, "argument"), count(results, "result")),
+		TypeParams:   typeParams,
+		FuncType:     funcType,
+		Mock:         name + "Mock" + typeArgs,
+		Stub:         stub,
+		Ongoing:      "*TypedOngoingStub[*" + stub + "]",
+		Params:       strings.Join(params, ", "),
+		Args:         strings.Join(argNames[:args], ", "),
+		ResultParams: strings.Join(resultParams, ", "),
+		Results:      strings.Join(resultNames[results], ", "),
+	}
+}
+
+func count(n int, noun string) string {
+	if n != 1 {
+		noun += "s"
+	}
+	return numbers[n] + " " + noun
+}
+
+// wrapComments splits the comment lines longer than commentWidth
+func wrapComments(source []byte) []byte {
+	var out bytes.Buffer
+	for _, line := range strings.SplitAfter(string(source), "\n") {
+		if !strings.HasPrefix(line, "// ") || len(strings.TrimSuffix(line, "\n")) <= commentWidth {
+			out.WriteString(line)
+			continue
+		}
+
+		current := "//"
+		for _, word := range strings.Fields(line[3:]) {
+			if len(current)+1+len(word) > commentWidth {
+				out.WriteString(current + "\n")
+				current = "//"
+			}
+			current += " " + word
+		}
+		out.WriteString(current + "\n")
+	}
+	return out.Bytes()
+}
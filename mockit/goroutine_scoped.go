@@ -0,0 +1,10 @@
+package mockit
+
+// GoroutineScoped makes the mock answer only the calls made by the test
+// goroutine, or by the goroutines it spawned; the calls made by any other
+// goroutine are forwarded to the real function, without being recorded
+func GoroutineScoped() Option {
+	return func(mock *instanceMock) {
+		mock.goroutineScoped = true
+	}
+}
@@ -0,0 +1,57 @@
+package mockit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+// backgroundCalls are performed by a goroutine that doesn't belong to any test
+var backgroundCalls = make(chan func())
+
+func init() {
+	go func() {
+		for call := range backgroundCalls {
+			call()
+		}
+	}()
+}
+
+func Test_GoroutineScoped(t *testing.T) {
+	m := &instanceMock{}
+
+	GoroutineScoped()(m)
+
+	assert.True(t, m.goroutineScoped)
+}
+
+func Test_GoroutineScoped_ShouldForwardTheCallsFromOtherGoroutinesToTheRealFunction(t *testing.T) {
+	m := MockFunc(t, filepath.Ext, GoroutineScoped())
+	m.With(argument.Any).Return("mocked")
+	done := make(chan string)
+
+	assert.Equal(t, "mocked", filepath.Ext("some-file.go"))
+	go func() {
+		done <- filepath.Ext("some-file.go")
+	}()
+	assert.Equal(t, "mocked", <-done)
+	backgroundCalls <- func() {
+		done <- filepath.Ext("some-file.go")
+	}
+	assert.Equal(t, ".go", <-done)
+	m.VerifyTimes(2, "some-file.go")
+}
+
+func Test_GoroutineScoped_ShouldAnswerTheCallsFromOtherGoroutinesIfNotSet(t *testing.T) {
+	m := MockFunc(t, filepath.Ext)
+	m.With(argument.Any).Return("mocked")
+	done := make(chan string)
+
+	backgroundCalls <- func() {
+		done <- filepath.Ext("some-file.go")
+	}
+	assert.Equal(t, "mocked", <-done)
+	m.VerifyTimes(1, "some-file.go")
+}
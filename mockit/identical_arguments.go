@@ -0,0 +1,35 @@
+package mockit
+
+import (
+	"reflect"
+
+	"github.com/pasdam/mockit/internal/utils"
+)
+
+// identicalArguments returns true if the arguments of two stubs are the same;
+// unlike callsMatch, functions (i.e. matchers) are identical only if they are
+// the same function value, as closures share the code pointer, while the
+// self-describing matchers are compared by their identity
+func identicalArguments(a []reflect.Value, b []reflect.Value) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	for i := 0; i < len(a); i++ {
+		if matcher, ok := a[i].Interface().(identicalMatcher); ok {
+			if !matcher.Identical(b[i].Interface()) {
+				return false
+			}
+
+		} else if a[i].Kind() == reflect.Func && b[i].Kind() == reflect.Func {
+			if utils.FuncValuePointer(a[i]) != utils.FuncValuePointer(b[i]) {
+				return false
+			}
+
+		} else if !reflect.DeepEqual(a[i].Interface(), b[i].Interface()) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,44 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_identicalArguments(t *testing.T) {
+	eq := argument.Eq(1)
+	tests := []struct {
+		name string
+		a    []interface{}
+		b    []interface{}
+		want bool
+	}{
+		{name: "Should be identical if the values are equal", a: []interface{}{"a", 1}, b: []interface{}{"a", 1}, want: true},
+		{name: "Should not be identical if a value is different", a: []interface{}{"a", 1}, b: []interface{}{"a", 2}, want: false},
+		{name: "Should not be identical if the count is different", a: []interface{}{"a"}, b: []interface{}{"a", 1}, want: false},
+		{name: "Should be identical if the functions are the same", a: []interface{}{argument.Any}, b: []interface{}{argument.Any}, want: true},
+		{name: "Should be identical if the closures are the same", a: []interface{}{eq}, b: []interface{}{eq}, want: true},
+		{name: "Should not be identical if the functions are different", a: []interface{}{argument.Any}, b: []interface{}{argument.Nil}, want: false},
+		{name: "Should not be identical if the closures are different", a: []interface{}{argument.Eq(1)}, b: []interface{}{argument.Eq(2)}, want: false},
+		{name: "Should be identical if the matchers are identical", a: []interface{}{argument.HasPrefix("a")}, b: []interface{}{argument.HasPrefix("a")}, want: true},
+		{name: "Should not be identical if the matchers are different", a: []interface{}{argument.HasPrefix("a")}, b: []interface{}{argument.HasPrefix("b")}, want: false},
+		{name: "Should not be identical if only one is a matcher", a: []interface{}{argument.Eq("a")}, b: []interface{}{"a"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			a := make([]reflect.Value, 0, len(tt.a))
+			for _, value := range tt.a {
+				a = append(a, reflect.ValueOf(value))
+			}
+			b := make([]reflect.Value, 0, len(tt.b))
+			for _, value := range tt.b {
+				b = append(b, reflect.ValueOf(value))
+			}
+
+			assert.Equal(t, tt.want, identicalArguments(a, b))
+		})
+	}
+}
@@ -0,0 +1,8 @@
+package mockit
+
+// identicalMatcher is a matcher that can tell whether another one matches the
+// same arguments (i.e. the self-describing matchers, whose closures can't be
+// compared)
+type identicalMatcher interface {
+	Identical(other interface{}) bool
+}
@@ -0,0 +1,11 @@
+package mockit
+
+import (
+	"testing"
+)
+
+// InOrder creates a new InOrderVerifier to verify the order of the calls made
+// on the specified mocks
+func InOrder(t *testing.T, mocks ...Mock) InOrderVerifier {
+	return manager.InOrder(t, mocks...)
+}
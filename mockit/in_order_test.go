@@ -0,0 +1,38 @@
+package mockit
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_InOrder_Example_ShouldVerifyTheOrderOfCallsAcrossMocks(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	base := MockFunc(t, filepath.Base)
+	ext := MockFunc(t, filepath.Ext)
+
+	filepath.Base("first")
+	filepath.Ext("second")
+	filepath.Base("third")
+
+	order := InOrder(t, base, ext)
+	order.Verify(base, "first")
+	order.Verify(ext, "second")
+	order.Verify(base, "third")
+}
+
+func Test_InOrder_ShouldFailIfCallsHappenedInADifferentOrder(t *testing.T) {
+	base := MockFunc(t, filepath.Base)
+	ext := MockFunc(t, filepath.Ext)
+
+	filepath.Base("first")
+	filepath.Ext("second")
+
+	mockT := new(testing.T)
+	order := InOrder(mockT, base, ext)
+	order.Verify(ext, "second")
+	order.Verify(base, "first")
+
+	assert.True(t, mockT.Failed())
+}
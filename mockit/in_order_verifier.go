@@ -0,0 +1,10 @@
+package mockit
+
+// InOrderVerifier contains methods to verify that calls on a group of mocks
+// happened in a specific order
+type InOrderVerifier interface {
+
+	// Verify fails the test if a call with the specified arguments wasn't made
+	// on the mock after the ones already verified
+	Verify(mock Mock, in ...interface{})
+}
@@ -0,0 +1,12 @@
+package mockit
+
+import "reflect"
+
+func inTypeProvider(typeOf reflect.Type) func(int) reflect.Type {
+	return func(i int) reflect.Type {
+		if typeOf.IsVariadic() && i >= typeOf.NumIn()-1 {
+			return typeOf.In(typeOf.NumIn() - 1).Elem()
+		}
+		return typeOf.In(i)
+	}
+}
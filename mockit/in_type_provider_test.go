@@ -0,0 +1,51 @@
+package mockit
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_inTypeProvider(t *testing.T) {
+	stringType := reflect.TypeOf("")
+	interfaceType := reflect.TypeOf((*interface{})(nil)).Elem()
+	tests := []struct {
+		name   string
+		typeOf reflect.Type
+		index  int
+		want   reflect.Type
+	}{
+		{
+			name:   "Non variadic function",
+			typeOf: reflect.TypeOf(filepath.Base),
+			index:  0,
+			want:   stringType,
+		},
+		{
+			name:   "Variadic function, fixed argument",
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			index:  0,
+			want:   stringType,
+		},
+		{
+			name:   "Variadic function, first variadic argument",
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			index:  1,
+			want:   interfaceType,
+		},
+		{
+			name:   "Variadic function, following variadic argument",
+			typeOf: reflect.TypeOf(fmt.Sprintf),
+			index:  5,
+			want:   interfaceType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, inTypeProvider(tt.typeOf)(tt.index))
+		})
+	}
+}
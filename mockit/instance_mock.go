@@ -1,58 +1,152 @@
 package mockit
 
 import (
+	"context"
+	"fmt"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pasdam/mockit/internal/format"
 )
 
 type instanceMock struct {
-	defaultOut  []reflect.Value
-	calls       [][]reflect.Value
-	enabled     bool
-	mockedCalls *callsIndex
-	t           *testing.T
-	target      *reflect.Value
+	callRecorded    chan struct{}
+	defaultOut      []reflect.Value
+	calls           [][]reflect.Value
+	enabled         bool
+	goroutineScoped bool
+	journal         *callsJournal
+	lock            sync.Mutex
+	mockedCalls     *callsIndex
+	name            string
+	reporting       int
+	strict          bool
+	t               *testing.T
+	target          *reflect.Value
+	unusedStubs     UnusedStubsLevel
+}
+
+// Answer records the call and returns the stubbed outputs for it, nil if the
+// real method should be called
+func (m *instanceMock) Answer(in []reflect.Value) []reflect.Value {
+	m.lock.Lock()
+	enabled, strict, reporting := m.enabled, m.strict, m.reporting > 0
+	m.lock.Unlock()
+	if !enabled || reporting {
+		return nil
+	}
+
+	m.RecordCall(in)
+
+	out, err := m.mockedCalls.MockedOutFor(in)
+	if err != nil {
+		if strict {
+			m.reportUnexpectedCall(in)
+		}
+		return m.defaultOut
+	}
+
+	return out
 }
 
 func (m *instanceMock) Disable() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	m.enabled = false
 }
 
 func (m *instanceMock) Enable() {
+	m.lock.Lock()
+	defer m.lock.Unlock()
 	m.enabled = true
 }
 
+func (m *instanceMock) Remove(stub Stub) {
+	builder, ok := stub.(*stubBuilder)
+	if !ok || builder.mock != m || builder.call == nil || !m.mockedCalls.Remove(builder.call) {
+		m.report(m.t.Error, "The stub is not configured on this mock, or it was already removed")
+	}
+}
+
+func (m *instanceMock) Reset() {
+	m.mockedCalls.Reset()
+	m.ResetCalls()
+}
+
+func (m *instanceMock) ResetCalls() {
+	m.lock.Lock()
+	m.calls = nil
+	m.lock.Unlock()
+
+	if m.journal != nil {
+		m.journal.Remove(m)
+	}
+}
+
 func (m *instanceMock) Verify(in ...interface{}) {
-	inValues := interfacesArrayToValuesArray(in, m.target.Type().In)
-	_, err := findCall(m.calls, inValues, func(fromCalls, in []reflect.Value) bool {
-		return callsMatch(in, fromCalls, true)
+	calls := m.recordedCalls()
+	inValues := argumentsToValues(in, m.target.Type())
+	if !containsCall(calls, inValues) {
+		m.reportMissingCall(inValues, calls, "")
+	}
+}
+
+func (m *instanceMock) VerifyAtLeast(times int, in ...interface{}) {
+	m.verifyCount(in, fmt.Sprintf("at least %d time(s)", times), func(count int) bool {
+		return count >= times
 	})
-	if err != nil {
-		builder := strings.Builder{}
-		builder.WriteString("Expected call: ")
-		builder.WriteString(format.PrintCall(m.target, inValues))
-		if len(m.calls) > 0 {
-			builder.WriteString("; but it recorded the following instead: ")
-			builder.WriteString(format.PrintCall(m.target, m.calls[0]))
-			for i := 1; i < len(m.calls); i++ {
-				builder.WriteString(", ")
-				builder.WriteString(format.PrintCall(m.target, m.calls[i]))
-			}
+}
 
-		} else {
-			builder.WriteString("; but no call was recorded")
-		}
-		m.t.Error(builder.String())
+func (m *instanceMock) VerifyAtMost(times int, in ...interface{}) {
+	m.verifyCount(in, fmt.Sprintf("at most %d time(s)", times), func(count int) bool {
+		return count <= times
+	})
+}
+
+func (m *instanceMock) VerifyBetween(min int, max int, in ...interface{}) {
+	m.verifyCount(in, fmt.Sprintf("between %d and %d time(s)", min, max), func(count int) bool {
+		return count >= min && count <= max
+	})
+}
+
+func (m *instanceMock) VerifyNever(in ...interface{}) {
+	m.verifyCount(in, "never", func(count int) bool {
+		return count == 0
+	})
+}
+
+func (m *instanceMock) VerifyWithin(timeout time.Duration, in ...interface{}) {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	inValues := argumentsToValues(in, m.target.Type())
+	if m.waitForCall(ctx, inValues) != nil {
+		m.reportMissingCall(inValues, m.recordedCalls(), fmt.Sprintf(" within %v", timeout))
 	}
 }
 
+func (m *instanceMock) VerifyTimes(times int, in ...interface{}) {
+	m.verifyCount(in, fmt.Sprintf("exactly %d time(s)", times), func(count int) bool {
+		return count == times
+	})
+}
+
+func (m *instanceMock) WaitForCall(ctx context.Context, in ...interface{}) <-chan error {
+	inValues := argumentsToValues(in, m.target.Type())
+	result := make(chan error, 1)
+	go func() {
+		result <- m.waitForCall(ctx, inValues)
+		close(result)
+	}()
+	return result
+}
+
 func (m *instanceMock) With(values ...interface{}) Stub {
-	typeOf := m.target.Type()
 	builder := &stubBuilder{
-		args: convertToValuesAndVerifies(m.t, values, typeOf.NumIn(), typeOf.In),
+		args: convertToArgumentsAndVerifies(m.t, values, m.target.Type()),
 		mock: m,
 	}
 
@@ -60,5 +154,149 @@ func (m *instanceMock) With(values ...interface{}) Stub {
 }
 
 func (m *instanceMock) RecordCall(in []reflect.Value) {
+	m.lock.Lock()
 	m.calls = append(m.calls, in)
+	if m.callRecorded != nil {
+		// notifies the waiters
+		close(m.callRecorded)
+		m.callRecorded = nil
+	}
+	m.lock.Unlock()
+
+	if m.journal != nil {
+		m.journal.Record(m, in)
+	}
+}
+
+// isGoroutineScoped returns true if the mock answers only the calls from the
+// goroutines of the test
+func (m *instanceMock) isGoroutineScoped() bool {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.goroutineScoped
+}
+
+// recordedCalls returns the calls recorded so far; the calls are only
+// appended, so the returned slice can be safely read without holding the lock
+func (m *instanceMock) recordedCalls() [][]reflect.Value {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.calls
+}
+
+// report logs the message through the test; the calls made to the mock
+// meanwhile (i.e. by the testing package, that holds the lock of the test) are
+// forwarded to the real function, as they might report again
+func (m *instanceMock) report(log func(args ...interface{}), message string) {
+	m.lock.Lock()
+	m.reporting++
+	m.lock.Unlock()
+	defer func() {
+		m.lock.Lock()
+		m.reporting--
+		m.lock.Unlock()
+	}()
+
+	log(message)
+}
+
+func (m *instanceMock) reportMissingCall(in []reflect.Value, calls [][]reflect.Value, expected string) {
+	builder := strings.Builder{}
+	builder.WriteString("Expected call: ")
+	builder.WriteString(format.PrintCall(m.name, in))
+	builder.WriteString(expected)
+	if len(calls) > 0 {
+		builder.WriteString("; but it recorded the following instead: ")
+		builder.WriteString(format.PrintCall(m.name, calls[0]))
+		for i := 1; i < len(calls); i++ {
+			builder.WriteString(", ")
+			builder.WriteString(format.PrintCall(m.name, calls[i]))
+		}
+
+	} else {
+		builder.WriteString("; but no call was recorded")
+	}
+	m.report(m.t.Error, builder.String())
+}
+
+func (m *instanceMock) reportUnexpectedCall(in []reflect.Value) {
+	builder := strings.Builder{}
+	builder.WriteString("Unexpected call: ")
+	builder.WriteString(format.PrintCall(m.name, in))
+	closest := closestCall(m.mockedCalls.Calls(), in)
+	if closest != nil {
+		builder.WriteString("; the closest stub is: ")
+		builder.WriteString(format.PrintCall(m.name, closest.in))
+	} else {
+		builder.WriteString("; no stub is configured")
+	}
+	m.report(m.t.Error, builder.String())
+}
+
+func (m *instanceMock) reportUnusedStubs() {
+	m.lock.Lock()
+	level := m.unusedStubs
+	m.lock.Unlock()
+	if level == UnusedStubsIgnore {
+		return
+	}
+
+	calls := m.mockedCalls.Calls()
+	for i := 0; i < len(calls); i++ {
+		call := calls[i]
+		if call.hits > 0 || call.times != nil {
+			continue
+		}
+
+		message := "Unused stub: " + format.PrintCall(m.name, call.in)
+		if level == UnusedStubsFail {
+			m.report(m.t.Error, message)
+		} else {
+			m.report(m.t.Log, message)
+		}
+	}
+}
+
+// waitForCall blocks until a call with the specified arguments is recorded,
+// or the context is done
+func (m *instanceMock) waitForCall(ctx context.Context, in []reflect.Value) error {
+	for {
+		// the channel is taken together with the calls, so that a call recorded
+		// after reading them is notified
+		m.lock.Lock()
+		calls := m.calls
+		if m.callRecorded == nil {
+			m.callRecorded = make(chan struct{})
+		}
+		callRecorded := m.callRecorded
+		m.lock.Unlock()
+
+		if containsCall(calls, in) {
+			return nil
+		}
+
+		select {
+		case <-callRecorded:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func (m *instanceMock) verifyExpectations() {
+	calls := m.mockedCalls.Calls()
+	for i := 0; i < len(calls); i++ {
+		call := calls[i]
+		if call.times != nil && *call.times != call.hits {
+			m.report(m.t.Error, fmt.Sprintf("Expected stub: %s to be used %d time(s); but it was used %d time(s)", format.PrintCall(m.name, call.in), *call.times, call.hits))
+		}
+	}
+}
+
+func (m *instanceMock) verifyCount(in []interface{}, expected string, check func(count int) bool) {
+	inValues := argumentsToValues(in, m.target.Type())
+	count := countCalls(m.recordedCalls(), inValues)
+	if !check(count) {
+		m.report(m.t.Error, fmt.Sprintf("Expected call: %s %s; but it was called %d time(s)", format.PrintCall(m.name, inValues), expected, count))
+	}
 }
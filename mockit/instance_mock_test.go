@@ -1,9 +1,13 @@
 package mockit
 
 import (
+	"context"
 	"path/filepath"
 	"reflect"
+	"strconv"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -283,11 +287,15 @@ func Test_instanceMock_RecordCall(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			m := &instanceMock{
-				calls: tt.fields.calls,
+				calls:   tt.fields.calls,
+				journal: &callsJournal{},
 			}
 
 			m.RecordCall(tt.args.in)
 
+			assert.Equal(t, 1, len(m.journal.entries))
+			assert.Equal(t, m, m.journal.entries[0].mock)
+
 			assert.Equal(t, len(tt.want), len(m.calls))
 			for i := 0; i < len(tt.want); i++ {
 				assert.Equal(t, len(tt.want[i]), len(m.calls[i]))
@@ -299,3 +307,689 @@ func Test_instanceMock_RecordCall(t *testing.T) {
 		})
 	}
 }
+
+func Test_instanceMock_VerifyAtLeast(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	calls := [][]reflect.Value{
+		{reflect.ValueOf("some-arg")},
+		{reflect.ValueOf("some-other-arg")},
+		{reflect.ValueOf("some-arg")},
+	}
+	tests := []struct {
+		name       string
+		times      int
+		shouldFail bool
+	}{
+		{
+			name:       "Called less times than expected",
+			times:      3,
+			shouldFail: true,
+		},
+		{
+			name:       "Called exactly the expected times",
+			times:      2,
+			shouldFail: false,
+		},
+		{
+			name:       "Called more times than expected",
+			times:      1,
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  calls,
+				t:      mockT,
+				target: &target,
+			}
+
+			m.VerifyAtLeast(tt.times, "some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_VerifyAtMost(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	calls := [][]reflect.Value{
+		{reflect.ValueOf("some-arg")},
+		{reflect.ValueOf("some-other-arg")},
+		{reflect.ValueOf("some-arg")},
+	}
+	tests := []struct {
+		name       string
+		times      int
+		shouldFail bool
+	}{
+		{
+			name:       "Called less times than expected",
+			times:      3,
+			shouldFail: false,
+		},
+		{
+			name:       "Called exactly the expected times",
+			times:      2,
+			shouldFail: false,
+		},
+		{
+			name:       "Called more times than expected",
+			times:      1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  calls,
+				t:      mockT,
+				target: &target,
+			}
+
+			m.VerifyAtMost(tt.times, "some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_VerifyBetween(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	calls := [][]reflect.Value{
+		{reflect.ValueOf("some-arg")},
+		{reflect.ValueOf("some-other-arg")},
+		{reflect.ValueOf("some-arg")},
+	}
+	tests := []struct {
+		name       string
+		min        int
+		max        int
+		shouldFail bool
+	}{
+		{
+			name:       "Called less times than the lower bound",
+			min:        3,
+			max:        4,
+			shouldFail: true,
+		},
+		{
+			name:       "Called a number of times in the range",
+			min:        1,
+			max:        3,
+			shouldFail: false,
+		},
+		{
+			name:       "Called a number of times equal to the bounds",
+			min:        2,
+			max:        2,
+			shouldFail: false,
+		},
+		{
+			name:       "Called more times than the upper bound",
+			min:        0,
+			max:        1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  calls,
+				t:      mockT,
+				target: &target,
+			}
+
+			m.VerifyBetween(tt.min, tt.max, "some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_VerifyNever(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	tests := []struct {
+		name       string
+		calls      [][]reflect.Value
+		shouldFail bool
+	}{
+		{
+			name:       "Not called",
+			calls:      nil,
+			shouldFail: false,
+		},
+		{
+			name: "Called with a different argument",
+			calls: [][]reflect.Value{
+				{reflect.ValueOf("some-other-arg")},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Called",
+			calls: [][]reflect.Value{
+				{reflect.ValueOf("some-other-arg")},
+				{reflect.ValueOf("some-arg")},
+			},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  tt.calls,
+				t:      mockT,
+				target: &target,
+			}
+
+			m.VerifyNever("some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_VerifyTimes(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	calls := [][]reflect.Value{
+		{reflect.ValueOf("some-arg")},
+		{reflect.ValueOf("some-other-arg")},
+		{reflect.ValueOf("some-arg")},
+	}
+	tests := []struct {
+		name       string
+		times      int
+		shouldFail bool
+	}{
+		{
+			name:       "Called less times than expected",
+			times:      3,
+			shouldFail: true,
+		},
+		{
+			name:       "Called exactly the expected times",
+			times:      2,
+			shouldFail: false,
+		},
+		{
+			name:       "Called more times than expected",
+			times:      1,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  calls,
+				t:      mockT,
+				target: &target,
+			}
+
+			m.VerifyTimes(tt.times, "some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_VerifyWithin(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	tests := []struct {
+		name       string
+		calls      [][]reflect.Value
+		asyncCall  []reflect.Value
+		shouldFail bool
+	}{
+		{
+			name: "Already called",
+			calls: [][]reflect.Value{
+				{reflect.ValueOf("some-arg")},
+			},
+			shouldFail: false,
+		},
+		{
+			name:       "Called asynchronously",
+			asyncCall:  []reflect.Value{reflect.ValueOf("some-arg")},
+			shouldFail: false,
+		},
+		{
+			name:       "Called asynchronously with a different argument",
+			asyncCall:  []reflect.Value{reflect.ValueOf("some-other-arg")},
+			shouldFail: true,
+		},
+		{
+			name:       "Not called",
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				calls:  tt.calls,
+				t:      mockT,
+				target: &target,
+			}
+			if tt.asyncCall != nil {
+				go func() {
+					time.Sleep(10 * time.Millisecond)
+					m.RecordCall(tt.asyncCall)
+				}()
+			}
+
+			timeout := time.Second
+			if tt.shouldFail {
+				timeout = 50 * time.Millisecond
+			}
+			m.VerifyWithin(timeout, "some-arg")
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_WaitForCall(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	m := &instanceMock{
+		t:      t,
+		target: &target,
+	}
+
+	result := m.WaitForCall(context.Background(), "some-arg")
+	m.RecordCall([]reflect.Value{reflect.ValueOf("some-other-arg")})
+	m.RecordCall([]reflect.Value{reflect.ValueOf("some-arg")})
+
+	select {
+	case err := <-result:
+		assert.Nil(t, err)
+	case <-time.After(time.Second):
+		assert.Fail(t, "The call was not notified")
+	}
+	_, open := <-result
+	assert.False(t, open)
+}
+
+func Test_instanceMock_WaitForCall_shouldReturnTheContextErrorIfItIsDone(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	m := &instanceMock{
+		t:      t,
+		target: &target,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+
+	result := m.WaitForCall(ctx, "some-arg")
+	cancel()
+
+	assert.Equal(t, context.Canceled, <-result)
+}
+
+func Test_instanceMock_verifyExpectations(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	one := 1
+	two := 2
+	tests := []struct {
+		name       string
+		calls      []*mockedCall
+		shouldFail bool
+	}{
+		{
+			name:       "No stubs",
+			calls:      nil,
+			shouldFail: false,
+		},
+		{
+			name: "Stubs without expectations",
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 0},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Expectations met",
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 1, times: &one},
+				{in: []reflect.Value{reflect.ValueOf("some-other-arg")}, hits: 2, times: &two},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Stub used less than expected",
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 1, times: &two},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Stub used more than expected",
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 2, times: &one},
+			},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				mockedCalls: &callsIndex{calls: tt.calls},
+				t:           mockT,
+				target:      &target,
+			}
+
+			m.verifyExpectations()
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_report(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	m := &instanceMock{
+		enabled:     true,
+		mockedCalls: &callsIndex{},
+		target:      &target,
+	}
+	var got string
+	var answered []reflect.Value
+
+	m.report(func(args ...interface{}) {
+		got = args[0].(string)
+		answered = m.Answer([]reflect.Value{reflect.ValueOf("some-arg")})
+	}, "some-message")
+
+	assert.Equal(t, "some-message", got)
+	assert.Nil(t, answered)
+	assert.Zero(t, m.reporting)
+	assert.Empty(t, m.calls)
+}
+
+func Test_instanceMock_reportUnexpectedCall(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	tests := []struct {
+		name  string
+		calls []*mockedCall
+	}{
+		{
+			name:  "No stubs",
+			calls: nil,
+		},
+		{
+			name: "With stubs",
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				mockedCalls: &callsIndex{calls: tt.calls},
+				t:           mockT,
+				target:      &target,
+			}
+
+			m.reportUnexpectedCall([]reflect.Value{reflect.ValueOf("some-other-arg")})
+
+			assert.True(t, mockT.Failed())
+			assert.Zero(t, m.reporting)
+		})
+	}
+}
+
+func Test_instanceMock_reportUnusedStubs(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	zero := 0
+	tests := []struct {
+		name        string
+		unusedStubs UnusedStubsLevel
+		calls       []*mockedCall
+		shouldFail  bool
+	}{
+		{
+			name:        "Should ignore unused stubs",
+			unusedStubs: UnusedStubsIgnore,
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 0},
+			},
+			shouldFail: false,
+		},
+		{
+			name:        "Should only warn for unused stubs",
+			unusedStubs: UnusedStubsWarn,
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 0},
+			},
+			shouldFail: false,
+		},
+		{
+			name:        "Should fail for unused stubs",
+			unusedStubs: UnusedStubsFail,
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 1},
+				{in: []reflect.Value{reflect.ValueOf("some-other-arg")}, hits: 0},
+			},
+			shouldFail: true,
+		},
+		{
+			name:        "Should not fail if all stubs were used",
+			unusedStubs: UnusedStubsFail,
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 1},
+			},
+			shouldFail: false,
+		},
+		{
+			name:        "Should not fail for stubs with declared expectations",
+			unusedStubs: UnusedStubsFail,
+			calls: []*mockedCall{
+				{in: []reflect.Value{reflect.ValueOf("some-arg")}, hits: 0, times: &zero},
+			},
+			shouldFail: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &instanceMock{
+				mockedCalls: &callsIndex{calls: tt.calls},
+				t:           mockT,
+				target:      &target,
+				unusedStubs: tt.unusedStubs,
+			}
+
+			m.reportUnusedStubs()
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_instanceMock_ShouldBeSafeForConcurrentUse(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	m := &instanceMock{
+		defaultOut:  defaultFuncOutput(target.Type()),
+		enabled:     true,
+		journal:     &callsJournal{},
+		mockedCalls: &callsIndex{},
+		t:           t,
+		target:      &target,
+	}
+	m.With("some-argument").Return("some-value")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				m.Answer([]reflect.Value{reflect.ValueOf("some-argument")})
+				if i%5 == 0 {
+					m.Disable()
+					m.Enable()
+				}
+				if i%7 == 0 {
+					m.VerifyAtMost(1000, "some-argument")
+				}
+			}
+		}(i)
+	}
+	wg.Wait()
+
+	m.VerifyAtLeast(1, "some-argument")
+	m.verifyExpectations()
+}
+
+func Test_instanceMock_ShouldAnswerCallsWhileStubbing(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	m := &instanceMock{
+		defaultOut:  defaultFuncOutput(target.Type()),
+		enabled:     true,
+		journal:     &callsJournal{},
+		mockedCalls: &callsIndex{},
+		t:           t,
+		target:      &target,
+	}
+	m.With("some-argument").Return("some-value")
+	done := make(chan struct{})
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+					out := m.Answer([]reflect.Value{reflect.ValueOf("some-argument")})
+					assert.Equal(t, "some-value", out[0].Interface())
+				}
+			}
+		}()
+	}
+	for i := 0; i < 10000; i++ {
+		m.With("some-argument").Return("some-value")
+	}
+	close(done)
+	wg.Wait()
+}
+
+func Test_instanceMock_Remove(t *testing.T) {
+	target := reflect.ValueOf(strconv.Itoa)
+	newMock := func() *instanceMock {
+		return &instanceMock{
+			defaultOut:  defaultFuncOutput(target.Type()),
+			enabled:     true,
+			mockedCalls: &callsIndex{},
+			t:           new(testing.T),
+			target:      &target,
+		}
+	}
+
+	t.Run("Should remove the stub", func(t *testing.T) {
+		m := newMock()
+		stub := m.With(1)
+		stub.Return("some-value")
+		m.With(2).Return("some-other-value")
+
+		m.Remove(stub)
+
+		assert.False(t, m.t.Failed())
+		assert.Equal(t, 1, len(m.mockedCalls.calls))
+		assert.Equal(t, "", m.Answer([]reflect.Value{reflect.ValueOf(1)})[0].Interface())
+		assert.Equal(t, "some-other-value", m.Answer([]reflect.Value{reflect.ValueOf(2)})[0].Interface())
+	})
+
+	t.Run("Should fail if the stub was already removed", func(t *testing.T) {
+		m := newMock()
+		stub := m.With(1)
+		stub.Return("some-value")
+		m.Remove(stub)
+
+		m.Remove(stub)
+
+		assert.True(t, m.t.Failed())
+	})
+
+	t.Run("Should fail if the stub is not configured", func(t *testing.T) {
+		m := newMock()
+
+		m.Remove(m.With(1))
+
+		assert.True(t, m.t.Failed())
+	})
+
+	t.Run("Should fail if the stub belongs to another mock", func(t *testing.T) {
+		m := newMock()
+		other := newMock()
+		stub := other.With(1)
+		stub.Return("some-value")
+
+		m.Remove(stub)
+
+		assert.True(t, m.t.Failed())
+		assert.Equal(t, 1, len(other.mockedCalls.calls))
+	})
+
+	t.Run("Should fail if the stub is nil", func(t *testing.T) {
+		m := newMock()
+
+		m.Remove(nil)
+
+		assert.True(t, m.t.Failed())
+	})
+}
+
+func Test_instanceMock_Reset(t *testing.T) {
+	target := reflect.ValueOf(strconv.Itoa)
+	journal := &callsJournal{}
+	m := &instanceMock{
+		defaultOut:  defaultFuncOutput(target.Type()),
+		enabled:     true,
+		journal:     journal,
+		mockedCalls: &callsIndex{},
+		t:           new(testing.T),
+		target:      &target,
+	}
+	m.With(1).Return("some-value")
+	m.Answer([]reflect.Value{reflect.ValueOf(1)})
+
+	m.Reset()
+
+	assert.Equal(t, 0, len(m.mockedCalls.calls))
+	assert.Equal(t, 0, len(m.calls))
+	assert.Equal(t, 0, len(journal.entries))
+	assert.Equal(t, "", m.Answer([]reflect.Value{reflect.ValueOf(1)})[0].Interface())
+}
+
+func Test_instanceMock_ResetCalls(t *testing.T) {
+	target := reflect.ValueOf(strconv.Itoa)
+	journal := &callsJournal{}
+	m := &instanceMock{
+		defaultOut:  defaultFuncOutput(target.Type()),
+		enabled:     true,
+		journal:     journal,
+		mockedCalls: &callsIndex{},
+		t:           new(testing.T),
+		target:      &target,
+	}
+	m.With(1).Return("some-value")
+	m.Answer([]reflect.Value{reflect.ValueOf(1)})
+
+	m.ResetCalls()
+
+	assert.Equal(t, 0, len(m.calls))
+	assert.Equal(t, 0, len(journal.entries))
+	m.VerifyNever(1)
+	assert.False(t, m.t.Failed())
+	assert.Equal(t, "some-value", m.Answer([]reflect.Value{reflect.ValueOf(1)})[0].Interface())
+}
@@ -0,0 +1,10 @@
+package mockit
+
+// InterfaceMock contains methods to access the mocks of the methods of an
+// interface
+type InterfaceMock interface {
+
+	// Method returns the Mock of the specified method, identified either by its
+	// name or by its method expression (i.e. io.Reader.Read)
+	Method(method interface{}) Mock
+}
@@ -0,0 +1,13 @@
+package mockit
+
+import (
+	"reflect"
+
+	"github.com/pasdam/mockit/matchers/argument"
+)
+
+var variadicMatcherType = reflect.TypeOf(argument.AnyRemaining)
+
+func isVariadicMatcher(value reflect.Value) bool {
+	return value.IsValid() && value.Type().AssignableTo(variadicMatcherType)
+}
@@ -0,0 +1,48 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_isVariadicMatcher(t *testing.T) {
+	tests := []struct {
+		name  string
+		value reflect.Value
+		want  bool
+	}{
+		{
+			name:  "Invalid value",
+			value: reflect.Value{},
+			want:  false,
+		},
+		{
+			name:  "Not a matcher",
+			value: reflect.ValueOf("some-value"),
+			want:  false,
+		},
+		{
+			name:  "Argument matcher",
+			value: reflect.ValueOf(argument.Any),
+			want:  false,
+		},
+		{
+			name:  "Variadic matcher",
+			value: reflect.ValueOf(argument.AnyRemaining),
+			want:  true,
+		},
+		{
+			name:  "Typed variadic matcher",
+			value: reflect.ValueOf(argument.VariadicMatcher(argument.AnyRemaining)),
+			want:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, isVariadicMatcher(tt.value))
+		})
+	}
+}
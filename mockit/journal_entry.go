@@ -0,0 +1,9 @@
+package mockit
+
+import "reflect"
+
+type journalEntry struct {
+	in   []reflect.Value
+	mock *instanceMock
+	seq  uint64
+}
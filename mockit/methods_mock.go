@@ -0,0 +1,51 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/internal/utils"
+)
+
+type methodsMock struct {
+	methods map[string]*instanceMock
+	scope   *mockScope
+	t       *testing.T
+}
+
+func (m *methodsMock) Method(method interface{}) Mock {
+	name, ok := method.(string)
+	if !ok {
+		value := reflect.ValueOf(method)
+		if value.Kind() != reflect.Func {
+			m.t.Errorf("Invalid method (%T), it should be either a name or a method expression", method)
+			return nil
+		}
+		name = utils.MethodName(utils.MethodFullyQualifiedName(value))
+	}
+
+	mock, found := m.methods[name]
+	if !found {
+		m.t.Errorf("The interface doesn't have a method called %s", name)
+		return nil
+	}
+
+	return mock
+}
+
+func (m *methodsMock) makeCall(mock *instanceMock, in []reflect.Value) []reflect.Value {
+	// the first argument is the receiver
+	in = expandVariadic(mock.target.Type(), in[1:])
+
+	if mock.isGoroutineScoped() && !m.scope.isAncestorOfCurrent() {
+		// the call doesn't come from the goroutines of the test
+		return mock.target.Call(in)
+	}
+
+	out := mock.Answer(in)
+	if out == nil {
+		return mock.target.Call(in)
+	}
+
+	return out
+}
@@ -0,0 +1,101 @@
+package mockit
+
+import (
+	"io"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_methodsMock_Method(t *testing.T) {
+	readMock := &instanceMock{}
+	tests := []struct {
+		name       string
+		method     interface{}
+		want       Mock
+		shouldFail bool
+	}{
+		{
+			name:       "Should find the method by name",
+			method:     "Read",
+			want:       readMock,
+			shouldFail: false,
+		},
+		{
+			name:       "Should find the method by method expression",
+			method:     io.Reader.Read,
+			want:       readMock,
+			shouldFail: false,
+		},
+		{
+			name:       "Should fail if the method doesn't exist",
+			method:     "Write",
+			want:       nil,
+			shouldFail: true,
+		},
+		{
+			name:       "Should fail if the method is neither a name nor a function",
+			method:     123,
+			want:       nil,
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			m := &methodsMock{
+				methods: map[string]*instanceMock{"Read": readMock},
+				t:       mockT,
+			}
+
+			got := m.Method(tt.method)
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_methodsMock_makeCall(t *testing.T) {
+	target := reflect.MakeFunc(reflect.TypeOf(func(string, ...int) string { return "" }), func(in []reflect.Value) []reflect.Value {
+		return []reflect.Value{reflect.ValueOf("real-value")}
+	})
+	tests := []struct {
+		name    string
+		enabled bool
+		want    string
+	}{
+		{
+			name:    "Should return the stubbed value",
+			enabled: true,
+			want:    "stubbed-value",
+		},
+		{
+			name:    "Should call the target if disabled",
+			enabled: false,
+			want:    "real-value",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mock := &instanceMock{
+				enabled: tt.enabled,
+				mockedCalls: &callsIndex{
+					calls: []*mockedCall{
+						{
+							in:      []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(1), reflect.ValueOf(2)},
+							answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("stubbed-value")})},
+						},
+					},
+				},
+				target: &target,
+			}
+			m := &methodsMock{}
+
+			got := m.makeCall(mock, []reflect.Value{reflect.ValueOf(nil), reflect.ValueOf("a"), reflect.ValueOf([]int{1, 2})})
+
+			assert.Equal(t, tt.want, got[0].Interface())
+		})
+	}
+}
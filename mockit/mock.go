@@ -1,5 +1,10 @@
 package mockit
 
+import (
+	"context"
+	"time"
+)
+
 // Mock contains methods to mock a call with specified arguments, and verify it
 type Mock interface {
 
@@ -9,9 +14,47 @@ type Mock interface {
 	// Enable restore the mock
 	Enable()
 
+	// Remove deletes the specified stub, that must have been configured on
+	// this mock, so the matching calls will be answered by the other stubs
+	Remove(stub Stub)
+
+	// Reset deletes all the stubs and the recorded calls
+	Reset()
+
+	// ResetCalls deletes the recorded calls, so they are not considered by
+	// the verifications anymore
+	ResetCalls()
+
 	// Verify fails the test if a call with the specified arguments wasn't made
 	Verify(in ...interface{})
 
+	// VerifyAtLeast fails the test if a call with the specified arguments
+	// wasn't made at least the specified number of times
+	VerifyAtLeast(times int, in ...interface{})
+
+	// VerifyAtMost fails the test if a call with the specified arguments was
+	// made more than the specified number of times
+	VerifyAtMost(times int, in ...interface{})
+
+	// VerifyBetween fails the test if the number of calls with the specified
+	// arguments is not in the range [min, max]
+	VerifyBetween(min int, max int, in ...interface{})
+
+	// VerifyNever fails the test if a call with the specified arguments was made
+	VerifyNever(in ...interface{})
+
+	// VerifyTimes fails the test if a call with the specified arguments wasn't
+	// made exactly the specified number of times
+	VerifyTimes(times int, in ...interface{})
+
+	// VerifyWithin waits for a call with the specified arguments, and fails the
+	// test if it isn't made within the timeout
+	VerifyWithin(timeout time.Duration, in ...interface{})
+
+	// WaitForCall returns a channel that receives nil once a call with the
+	// specified arguments is made, or the context error if it is done before
+	WaitForCall(ctx context.Context, in ...interface{}) <-chan error
+
 	// With configures the mock to respond to the specified arguments
 	With(values ...interface{}) Stub
 }
@@ -5,6 +5,6 @@ import (
 )
 
 // MockFunc creates a new Mock to mock a function
-func MockFunc(t *testing.T, targetFn interface{}) Mock {
-	return manager.MockFunc(t, targetFn)
+func MockFunc(t *testing.T, targetFn interface{}, options ...Option) Mock {
+	return manager.MockFunc(t, targetFn, options...)
 }
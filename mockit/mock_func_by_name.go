@@ -0,0 +1,13 @@
+package mockit
+
+import (
+	"testing"
+)
+
+// MockFuncByName creates a new Mock to mock a function, also unexported,
+// identified by its fully qualified name (i.e. "github.com/user/pkg.helper").
+// As the name doesn't carry any type information, the signature of the
+// function must be specified, i.e. (func(string) int)(nil).
+func MockFuncByName(t *testing.T, name string, signature interface{}, options ...Option) Mock {
+	return manager.MockFuncByName(t, name, signature, options...)
+}
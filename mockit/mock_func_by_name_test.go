@@ -0,0 +1,58 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+//go:noinline
+func unexportedTestHelper(s string, values ...int) int {
+	result := len(s)
+	for _, v := range values {
+		result += v
+	}
+	return result
+}
+
+func Test_MockFuncByName_ShouldMockTheFunction(t *testing.T) {
+	m := MockFuncByName(t, "github.com/pasdam/mockit/mockit.unexportedTestHelper", (func(string, ...int) int)(nil))
+	m.With("some-argument", 1, 2).Return(42)
+	m.With("other-argument", argument.AnyRemaining).CallRealMethod()
+
+	assert.Equal(t, 42, unexportedTestHelper("some-argument", 1, 2))
+	assert.Equal(t, 17, unexportedTestHelper("other-argument", 1, 2))
+	assert.Equal(t, 0, unexportedTestHelper("not-stubbed"))
+	m.Verify("some-argument", 1, 2)
+	m.VerifyTimes(1, "other-argument", 1, 2)
+}
+
+func Test_MockFuncByName_ShouldRemoveTheMockWhenTheTestCompletes(t *testing.T) {
+	t.Run("", func(t *testing.T) {
+		m := MockFuncByName(t, "github.com/pasdam/mockit/mockit.unexportedTestHelper", (func(string, ...int) int)(nil))
+		m.With("some-argument").Return(42)
+
+		assert.Equal(t, 42, unexportedTestHelper("some-argument"))
+	})
+
+	assert.Equal(t, 13, unexportedTestHelper("some-argument"))
+}
+
+func Test_MockFuncByName_ShouldFailIfTheSymbolIsNotFound(t *testing.T) {
+	mockT := new(testing.T)
+
+	got := MockFuncByName(mockT, "github.com/pasdam/mockit/mockit.notExistingFunction", (func())(nil))
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
+
+func Test_MockFuncByName_ShouldFailIfTheSignatureIsNotAFunction(t *testing.T) {
+	mockT := new(testing.T)
+
+	got := MockFuncByName(mockT, "github.com/pasdam/mockit/mockit.unexportedTestHelper", "some-signature")
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
@@ -1,8 +1,17 @@
 package mockit
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
 	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/pasdam/mockit/matchers/argument"
 	"github.com/stretchr/testify/assert"
@@ -96,3 +105,472 @@ func Test_mockFunc_ShouldDisableAndRestoreAMock(t *testing.T) {
 	assert.Equal(t, 2, len(m.calls))
 	m.Verify("matching-argument")
 }
+
+func Test_MockFunc_Example_ShouldVerifyTheNumberOfInvocations(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Base)
+	m.With(argument.Any).Return("result")
+
+	filepath.Base("some-argument")
+	filepath.Base("some-argument")
+	filepath.Base("some-argument")
+
+	m.VerifyTimes(3, "some-argument")
+	m.VerifyAtLeast(2, "some-argument")
+	m.VerifyAtMost(3, "some-argument")
+	m.VerifyBetween(1, 3, "some-argument")
+	m.VerifyNever("some-other-argument")
+}
+
+func Test_MockFunc_Example_ShouldVerifyExpectationsAtTheEndOfTheTest(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Base)
+	m.With("some-argument").Times(2).Return("result")
+
+	filepath.Base("some-argument")
+	filepath.Base("some-argument")
+}
+
+func Test_MockFunc_ShouldFailTheTestForUnexpectedCallsIfStrict(t *testing.T) {
+	mockT := new(testing.T)
+	m := MockFunc(t, filepath.Base, Strict()).(*instanceMock)
+	m.t = mockT
+	m.With("some-argument").Return("result")
+
+	assert.Equal(t, "result", filepath.Base("some-argument"))
+	assert.False(t, mockT.Failed())
+
+	assert.Equal(t, "", filepath.Base("some-other-argument"))
+	assert.True(t, mockT.Failed())
+}
+
+func Test_MockFunc_ShouldReportTheFailuresOfStrictMocks(t *testing.T) {
+	mockT := new(testing.T)
+	m := MockFunc(t, filepath.Base, Strict()).(*instanceMock)
+	m.t = mockT
+	m.With("some-argument").Return("result")
+
+	// the testing package calls the mocked function to report the failure
+	m.Verify("some-other-argument")
+
+	assert.True(t, mockT.Failed())
+	assert.Empty(t, m.recordedCalls())
+}
+
+func Test_MockFunc_Example_ShouldStubConsecutiveCalls(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Base)
+	m.With("some-argument").Return("first").Then().Return("second").Then().CallRealMethod()
+
+	assert.Equal(t, "first", filepath.Base("some-argument"))
+	assert.Equal(t, "second", filepath.Base("some-argument"))
+	assert.Equal(t, "some-argument", filepath.Base("some-argument"))
+	assert.Equal(t, "some-argument", filepath.Base("some-argument"))
+}
+
+func Test_MockFunc_Example_ShouldComputeTheResultWithACallback(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Base)
+	m.With(argument.Any).DoAndReturn(func(path string) string {
+		return "base-of-" + path
+	})
+
+	assert.Equal(t, "base-of-some-argument", filepath.Base("some-argument"))
+}
+
+func Test_MockFunc_Example_ShouldMockVariadicFunctions(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Join)
+	m.With("a", "b", "c").Return("result")
+	m.With("d", argument.AnyRemaining).Return("other-result")
+
+	assert.Equal(t, "result", filepath.Join("a", "b", "c"))
+	assert.Equal(t, "other-result", filepath.Join("d"))
+	assert.Equal(t, "other-result", filepath.Join("d", "e", "f"))
+	assert.Equal(t, "", filepath.Join("a", "b"))
+	m.Verify("a", "b", "c")
+	m.VerifyTimes(2, "d", argument.AnyRemaining)
+	m.VerifyTimes(1, "a", argument.Any)
+}
+
+func Test_MockFunc_ShouldCallTheRealVariadicFunction(t *testing.T) {
+	m := MockFunc(t, fmt.Sprintf)
+	m.With("%s-%d", "a", argument.Any).CallRealMethod()
+
+	assert.Equal(t, "a-1", fmt.Sprintf("%s-%d", "a", 1))
+	assert.Equal(t, "", fmt.Sprintf("%s-%d", "b", 1))
+	m.Verify("%s-%d", "a", 1)
+}
+
+func Test_mockFunc_ShouldBeSafeForConcurrentCalls(t *testing.T) {
+	m := MockFunc(t, filepath.Base)
+	m.With("stubbed").Return("result")
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				assert.Equal(t, "result", filepath.Base("stubbed"))
+				assert.Equal(t, "", filepath.Base("not-stubbed"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	m.VerifyTimes(1000, "stubbed")
+	m.VerifyTimes(1000, "not-stubbed")
+}
+
+func Test_mockFunc_ShouldBeSafeForConcurrentCallsToTheRealFunction(t *testing.T) {
+	m := MockFunc(t, filepath.Base)
+	m.With(argument.Any).CallRealMethod()
+
+	wg := sync.WaitGroup{}
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				assert.Equal(t, "real", filepath.Base("/some/real"))
+			}
+		}()
+	}
+	wg.Wait()
+
+	// the calls made while another one is in progress reach the real function
+	// directly, without being recorded
+	m.VerifyBetween(1, 1000, "/some/real")
+}
+
+//go:noinline
+func mockFuncTestBlock(started, release chan struct{}) string {
+	close(started)
+	<-release
+	return "real"
+}
+
+func Test_mockFunc_ShouldLetConcurrentCallsReachTheRealFunctionWhileCallingIt(t *testing.T) {
+	started, release := make(chan struct{}), make(chan struct{})
+	otherStarted, otherRelease := make(chan struct{}), make(chan struct{})
+	m := MockFunc(t, mockFuncTestBlock)
+	m.With(started, release).CallRealMethod()
+	m.With(otherStarted, otherRelease).Return("result")
+	done := make(chan string, 2)
+
+	go func() {
+		done <- mockFuncTestBlock(started, release)
+	}()
+	<-started
+	// the patch is removed until the real function returns
+	go func() {
+		done <- mockFuncTestBlock(otherStarted, otherRelease)
+	}()
+	<-otherStarted
+	close(release)
+	close(otherRelease)
+
+	assert.Equal(t, "real", <-done)
+	assert.Equal(t, "real", <-done)
+	m.VerifyTimes(1, started, release)
+	m.VerifyNever(otherStarted, otherRelease)
+}
+
+func Test_mockFunc_ShouldScopeTheMocksToTheTest(t *testing.T) {
+	m := MockFunc(t, filepath.Ext)
+	m.With(argument.Any).Return("parent")
+
+	t.Run("", func(t *testing.T) {
+		m := MockFunc(t, filepath.Ext)
+		m.With(argument.Any).Return("child")
+
+		assert.Equal(t, "child", filepath.Ext("some-file"))
+		done := make(chan string)
+		go func() {
+			done <- filepath.Ext("some-file")
+		}()
+		assert.Equal(t, "child", <-done)
+		m.VerifyTimes(2, "some-file")
+	})
+
+	assert.Equal(t, "parent", filepath.Ext("some-file"))
+	m.VerifyTimes(1, "some-file")
+}
+
+func Test_mockFunc_ShouldIsolateConcurrentTests(t *testing.T) {
+	firstMocked := make(chan struct{})
+	firstCompleted := make(chan struct{})
+	wg := sync.WaitGroup{}
+	wg.Add(2)
+
+	// the subtests are run concurrently regardless of the -parallel flag
+	go func() {
+		defer wg.Done()
+		t.Run("first", func(t *testing.T) {
+			// registered before the mock, so it runs after the mock is removed
+			t.Cleanup(func() {
+				close(firstCompleted)
+			})
+
+			m := MockFunc(t, filepath.Ext)
+			m.With(argument.Any).Return("first")
+			close(firstMocked)
+
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, "first", filepath.Ext("some-file"))
+			}
+		})
+	}()
+
+	go func() {
+		defer wg.Done()
+		t.Run("second", func(t *testing.T) {
+			<-firstMocked
+
+			m := MockFunc(t, filepath.Ext)
+			m.With(argument.Any).Return("second")
+
+			for i := 0; i < 20; i++ {
+				assert.Equal(t, "second", filepath.Ext("some-file"))
+				done := make(chan string)
+				go func() {
+					done <- filepath.Ext("some-file")
+				}()
+				assert.Equal(t, "second", <-done)
+			}
+
+			<-firstCompleted
+			assert.Equal(t, "second", filepath.Ext("some-file"))
+		})
+	}()
+
+	wg.Wait()
+	assert.Equal(t, ".go", filepath.Ext("some-file.go"))
+}
+
+func Test_mockFunc_ShouldCallTheRealFunctionIfTheCallCantBeAttributedToATest(t *testing.T) {
+	m := MockFunc(t, filepath.Ext)
+	m.With(argument.Any).Return("parent")
+
+	t.Run("", func(t *testing.T) {
+		m := MockFunc(t, filepath.Ext)
+		m.With(argument.Any).Return("child")
+		done := make(chan string)
+
+		backgroundCalls <- func() {
+			done <- filepath.Ext("some-file.go")
+		}
+
+		assert.Equal(t, ".go", <-done)
+		m.VerifyNever("some-file.go")
+	})
+
+	m.VerifyNever("some-file.go")
+}
+
+func Test_mockFunc_ShouldPanicAndRecordTheCall(t *testing.T) {
+	m := MockFunc(t, filepath.Base)
+	m.With("some-argument").Panic("some-panic").Then().Return("result")
+
+	assert.PanicsWithValue(t, "some-panic", func() {
+		filepath.Base("some-argument")
+	})
+	assert.Equal(t, "result", filepath.Base("some-argument"))
+	m.VerifyTimes(2, "some-argument")
+}
+
+func Test_MockFunc_Example_ShouldSetTheArguments(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, json.Unmarshal)
+	m.With(argument.Any, argument.Any).SetArg(1, map[string]interface{}{"key": "value"}).Return(nil)
+
+	var got map[string]interface{}
+	err := json.Unmarshal([]byte("{}"), &got)
+
+	assert.Nil(t, err)
+	assert.Equal(t, map[string]interface{}{"key": "value"}, got)
+}
+
+func Test_mockFunc_ShouldSetTheVariadicArgumentsForEachAnswer(t *testing.T) {
+	m := MockFunc(t, fmt.Sscan)
+	m.With("some-input", argument.AnyRemaining).
+		SetArg(1, "a").SetArg(2, 1).Return(2, nil).
+		Then().SetArg(1, "b").Return(1, nil)
+
+	var s string
+	var i int
+	n, err := fmt.Sscan("some-input", &s, &i)
+	assert.Equal(t, 2, n)
+	assert.Nil(t, err)
+	assert.Equal(t, "a", s)
+	assert.Equal(t, 1, i)
+
+	i = 0
+	n, err = fmt.Sscan("some-input", &s, &i)
+	assert.Equal(t, 1, n)
+	assert.Nil(t, err)
+	assert.Equal(t, "b", s)
+	assert.Equal(t, 0, i)
+}
+
+func Test_MockFunc_Example_ShouldReturnTheArguments(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Abs)
+	m.With(argument.Any).ReturnArg(0)
+	n := MockFunc(t, filepath.Rel)
+	n.With(argument.Any, argument.Any).Return(Arg(1), nil)
+
+	got, err := filepath.Abs("some-path")
+	assert.Equal(t, "some-path", got)
+	assert.Nil(t, err)
+	got, err = filepath.Rel("some-base", "some-target")
+	assert.Equal(t, "some-target", got)
+	assert.Nil(t, err)
+}
+
+//go:noinline
+func mockFuncTestFetch(ctx context.Context, id string) (string, error) {
+	return id, nil
+}
+
+func Test_MockFunc_Example_ShouldReturnTheContextErrorIfTheCallTakesTooLong(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, mockFuncTestFetch)
+	m.With(argument.Any, "some-id").DelayContext(time.Hour).Return("some-value", nil)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	got, err := mockFuncTestFetch(ctx, "some-id")
+
+	assert.Equal(t, "", got)
+	assert.Equal(t, context.DeadlineExceeded, err)
+	m.Verify(argument.Any, "some-id")
+}
+
+func Test_mockFunc_ShouldBlockTheCallUntilTheChannelIsClosed(t *testing.T) {
+	m := MockFunc(t, filepath.Base)
+	ch := make(chan struct{})
+	m.With("some-argument").BlockUntil(ch).Return("result")
+
+	done := make(chan string, 1)
+	go func() {
+		done <- filepath.Base("some-argument")
+	}()
+	m.VerifyWithin(time.Second, "some-argument")
+	select {
+	case <-done:
+		t.Error("The call should be blocked")
+		return
+	case <-time.After(10 * time.Millisecond):
+	}
+
+	close(ch)
+	assert.Equal(t, "result", <-done)
+}
+
+func Test_mockFunc_ShouldDelayTheCall(t *testing.T) {
+	m := MockFunc(t, mockFuncTestFetch)
+	m.With(argument.Any, "some-id").DelayContext(10*time.Millisecond).Return("some-value", nil).
+		Then().Delay(10 * time.Millisecond).ReturnDefaults()
+
+	start := time.Now()
+	got, err := mockFuncTestFetch(context.Background(), "some-id")
+	assert.Equal(t, "some-value", got)
+	assert.Nil(t, err)
+	assert.GreaterOrEqual(t, time.Since(start), 10*time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	got, err = mockFuncTestFetch(ctx, "some-id")
+	assert.Equal(t, "", got)
+	assert.Nil(t, err, "the context should be ignored")
+}
+
+func Test_MockFunc_Example_ShouldOverrideTheStubs(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, filepath.Base)
+	m.With("some-argument").Return("result")
+	stub := m.With(argument.Any)
+	stub.Return("any-result")
+
+	m.With("some-argument").Return("other-result")
+	assert.Equal(t, "other-result", filepath.Base("some-argument"))
+
+	m.Remove(stub)
+	assert.Equal(t, "", filepath.Base("some-other-argument"))
+
+	m.ResetCalls()
+	m.VerifyNever("some-argument")
+
+	m.Reset()
+	assert.Equal(t, "", filepath.Base("some-argument"))
+	m.VerifyTimes(1, "some-argument")
+}
+
+func Test_MockFunc_Example_ShouldUseTheArgumentMatchers(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, strconv.Quote)
+	m.With(argument.AnyOf("a", "b")).Return("a-or-b")
+	m.With(argument.Not(argument.Zero)).Return("not-empty")
+
+	assert.Equal(t, "a-or-b", strconv.Quote("a"))
+	assert.Equal(t, "a-or-b", strconv.Quote("b"))
+	assert.Equal(t, "not-empty", strconv.Quote("c"))
+	assert.Equal(t, "", strconv.Quote(""))
+	m.VerifyTimes(3, argument.NotZero)
+	m.VerifyNever(argument.Not(argument.IsA[string]()))
+}
+
+func Test_mockFunc_ShouldNotReplaceTheStubsWithDifferentMatchers(t *testing.T) {
+	m := MockFunc(t, strconv.Quote)
+	m.With(argument.Eq("a")).Return("result-a")
+	m.With(argument.Eq("b")).Return("result-b")
+
+	assert.Equal(t, "result-a", strconv.Quote("a"))
+	assert.Equal(t, "result-b", strconv.Quote("b"))
+}
+
+func Test_mockFunc_ShouldReplaceTheStubsWithIdenticalMatchers(t *testing.T) {
+	m := MockFunc(t, strconv.Quote)
+	m.With(argument.HasPrefix("a")).Return("first")
+	m.With(argument.HasPrefix("a")).Return("second")
+
+	assert.Equal(t, "second", strconv.Quote("abc"))
+}
+
+func Test_MockFunc_Example_ShouldUseTheOrderingMatchers(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, strconv.FormatFloat)
+	m.With(argument.InDelta(0.5, 0.01), argument.Any, argument.Any, argument.Any).Return("half")
+	m.With(argument.Gt(1), argument.Any, argument.Any, argument.Any).Return("big")
+
+	assert.Equal(t, "half", strconv.FormatFloat(0.501, 'f', -1, 64))
+	assert.Equal(t, "big", strconv.FormatFloat(2, 'f', -1, 64))
+	assert.Equal(t, "", strconv.FormatFloat(0.9, 'f', -1, 64))
+	m.VerifyTimes(3, argument.Between(0, 2), argument.Any, argument.Any, argument.Any)
+}
+
+func Test_MockFunc_Example_ShouldUseTheStringMatchers(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, url.Parse)
+	m.With(argument.HasPrefix("ftp://")).Return(nil, errors.New("unsupported scheme"))
+
+	_, err := url.Parse("ftp://host/file")
+
+	assert.Equal(t, errors.New("unsupported scheme"), err)
+	m.Verify(argument.Regexp(`^ftp://\w+/`))
+	m.VerifyNever(argument.EqualFold("FTP://HOST"))
+}
+
+func Test_MockFunc_Example_ShouldUseTheCollectionMatchers(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	m := MockFunc(t, strings.Join)
+	m.With(argument.ElementsMatch("b", "a"), argument.Any).Return("a-and-b")
+	m.With(argument.AllOf(argument.HasLen(3), argument.Each(argument.NotZero)), ",").Return("three")
+
+	assert.Equal(t, "a-and-b", strings.Join([]string{"a", "b"}, ","))
+	assert.Equal(t, "three", strings.Join([]string{"x", "y", "z"}, ","))
+	assert.Equal(t, "", strings.Join([]string{"x", "", "z"}, ","))
+	m.VerifyTimes(2, argument.ContainsElement("x"), argument.Any)
+}
@@ -3,8 +3,11 @@ package mockit
 import (
 	"log"
 	"reflect"
+	"sync"
+	"testing"
 
 	"bou.ke/monkey"
+	"github.com/pasdam/mockit/internal/goroutines"
 	"github.com/pasdam/mockit/internal/utils"
 )
 
@@ -14,56 +17,132 @@ type mockGuard struct {
 	defaultOut         []reflect.Value
 	guard              *monkey.PatchGuard
 	fullyQualifiedName string
-	mockedInstances    map[interface{}]*instanceMock
+	lock               sync.RWMutex
 	provider           callMetadataProvider
+	realLock           sync.Mutex
+	scopes             []*mockScope
 	targetFunc         reflect.Value
 }
 
 func (g *mockGuard) makeCall(in []reflect.Value) []reflect.Value {
+	g.lock.RLock()
 	instance, realTarget, in := g.provider(in)
+	in = expandVariadic(g.targetFunc.Type(), in)
 
-	mock, found := g.mockedInstances[instance]
-	if !found {
-		mock, found = g.mockedInstances[nil]
-	}
-	if !found {
-		if realTarget == nil {
-			log.Fatal("Unexpected error: mocked func/method not found and real target is nil, unable to perform call")
-		}
-		return g.callReal(realTarget.Call, in)
+	var mock *instanceMock
+	found := false
+	scope, verified := g.scopeForCall()
+	if scope != nil {
+		mock, found = scope.mockFor(instance)
 	}
+	g.lock.RUnlock()
 
-	var out []reflect.Value
-	if mock.enabled {
-		mock.RecordCall(in)
+	if found && !verified && mock.isGoroutineScoped() && !scope.isAncestorOfCurrent() {
+		// the call doesn't come from the goroutines of the test
+		found = false
+	}
 
-		var err error
-		out, err = mock.mockedCalls.MockedOutFor(in)
-		if err != nil {
-			return g.defaultOut
+	if found {
+		if out := mock.Answer(in); out != nil {
+			return out
 		}
 	}
 
-	if out == nil {
-		return g.callReal(mock.target.Call, in)
+	if realTarget == nil {
+		log.Fatal("Unexpected error: real target is nil, unable to perform call")
 	}
-
-	return out
+	return g.callReal(realTarget.Call, in)
 }
 
+// callReal temporarily removes the patch to call the real target; as the patch
+// is process wide, concurrent calls to the real target are serialized, and the
+// calls made meanwhile reach the real target directly, without being recorded
 func (g *mockGuard) callReal(realTarget func(in []reflect.Value) []reflect.Value, in []reflect.Value) []reflect.Value {
+	g.realLock.Lock()
+	defer g.realLock.Unlock()
+
 	g.guard.Unpatch()
 	defer g.guard.Restore()
 	return realTarget(in)
 }
 
+// mocks returns the mocked instances of the scope
+func (g *mockGuard) mocks(scope *mockScope) []*instanceMock {
+	g.lock.RLock()
+	defer g.lock.RUnlock()
+
+	result := make([]*instanceMock, 0, len(scope.mockedInstances))
+	for _, mock := range scope.mockedInstances {
+		result = append(result, mock)
+	}
+	return result
+}
+
+// removeScope removes the scope and returns the number of the remaining ones
+func (g *mockGuard) removeScope(scope *mockScope) int {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	for i := 0; i < len(g.scopes); i++ {
+		if g.scopes[i] == scope {
+			g.scopes = append(g.scopes[:i], g.scopes[i+1:]...)
+			break
+		}
+	}
+	return len(g.scopes)
+}
+
+// scopeFor returns the scope of the test, nil if it doesn't exist; the caller
+// must hold the lock
+func (g *mockGuard) scopeFor(t *testing.T) *mockScope {
+	for i := 0; i < len(g.scopes); i++ {
+		if g.scopes[i].t == t {
+			return g.scopes[i]
+		}
+	}
+	return nil
+}
+
+// scopeForCall returns the scope the current call belongs to, that is the one
+// of the closest test goroutine among the ancestors of the calling one; the
+// flag is true if the scope has been identified through the ancestors, which
+// happens only if there are multiple scopes. The caller must hold the lock.
+func (g *mockGuard) scopeForCall() (*mockScope, bool) {
+	switch len(g.scopes) {
+	case 0:
+		return nil, false
+	case 1:
+		return g.scopes[0], false
+	}
+
+	var scope *mockScope
+	goroutines.FindAncestor(func(id uint64) bool {
+		for i := 0; i < len(g.scopes); i++ {
+			if g.scopes[i].goroutine == id {
+				scope = g.scopes[i]
+				return true
+			}
+		}
+		return false
+	})
+	return scope, true
+}
+
+// unpatch removes the patch, waiting for any ongoing call to the real target
+func (g *mockGuard) unpatch() {
+	g.realLock.Lock()
+	defer g.realLock.Unlock()
+
+	g.guard.Unpatch()
+}
+
 func (g *mockGuard) patchFunc(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
 	instanceType := g.targetFunc.Type()
 	replacement := reflect.MakeFunc(instanceType, g.makeCall)
 	mg := monkey.Patch(g.targetFunc.Interface(), replacement.Interface())
 
 	provider := func(in []reflect.Value) (interface{}, *reflect.Value, []reflect.Value) {
-		return nil, nil, in
+		return nil, &g.targetFunc, in
 	}
 
 	return mg, provider
@@ -92,3 +171,21 @@ func (g *mockGuard) patchMethod(instance interface{}) (*monkey.PatchGuard, callM
 
 	return mg, provider
 }
+
+func (g *mockGuard) patchUnexportedMethod(method reflect.Value) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
+	return func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
+		replacement := reflect.MakeFunc(method.Type(), g.makeCall)
+		mg := monkey.Patch(method.Interface(), replacement.Interface())
+
+		provider := func(in []reflect.Value) (interface{}, *reflect.Value, []reflect.Value) {
+			instanceValue := in[0]
+
+			instance := instanceValue.Interface()
+			realTarget := bindReceiver(method, instanceValue, g.targetFunc.Type())
+
+			return instance, &realTarget, in[1:]
+		}
+
+		return mg, provider
+	}
+}
@@ -0,0 +1,78 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/internal/goroutines"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mockGuard_removeScope(t *testing.T) {
+	scope1 := &mockScope{}
+	scope2 := &mockScope{}
+	guard := &mockGuard{scopes: []*mockScope{scope1, scope2}}
+
+	assert.Equal(t, 1, guard.removeScope(scope1))
+	assert.Equal(t, []*mockScope{scope2}, guard.scopes)
+	assert.Equal(t, 1, guard.removeScope(scope1))
+	assert.Equal(t, 0, guard.removeScope(scope2))
+}
+
+func Test_mockGuard_scopeFor(t *testing.T) {
+	otherT := new(testing.T)
+	scope := &mockScope{t: t}
+	guard := &mockGuard{scopes: []*mockScope{{t: otherT}, scope}}
+
+	assert.Equal(t, scope, guard.scopeFor(t))
+	assert.Nil(t, guard.scopeFor(new(testing.T)))
+}
+
+func Test_mockGuard_scopeForCall(t *testing.T) {
+	self := goroutines.Current()
+	otherScope := &mockScope{goroutine: 0}
+	scope := &mockScope{goroutine: self}
+
+	tests := []struct {
+		name         string
+		scopes       []*mockScope
+		want         *mockScope
+		wantVerified bool
+	}{
+		{
+			name: "No scopes",
+		},
+		{
+			name:   "Single scope",
+			scopes: []*mockScope{otherScope},
+			want:   otherScope,
+		},
+		{
+			name:         "Scope of an ancestor",
+			scopes:       []*mockScope{otherScope, scope},
+			want:         scope,
+			wantVerified: true,
+		},
+		{
+			name:         "No scope of an ancestor",
+			scopes:       []*mockScope{otherScope, {goroutine: 0}},
+			wantVerified: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			guard := &mockGuard{scopes: tt.scopes}
+			done := make(chan bool)
+
+			var got *mockScope
+			var gotVerified bool
+			go func() {
+				got, gotVerified = guard.scopeForCall()
+				close(done)
+			}()
+			<-done
+
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.wantVerified, gotVerified)
+		})
+	}
+}
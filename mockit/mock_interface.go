@@ -0,0 +1,12 @@
+package mockit
+
+import (
+	"testing"
+)
+
+// MockInterface creates a new implementation of the interface pointed by
+// target, i.e. MockInterface(t, &reader) where reader is an io.Reader, whose
+// methods can be mocked in the same way of functions
+func MockInterface(t *testing.T, target interface{}, options ...Option) InterfaceMock {
+	return manager.MockInterface(t, target, options...)
+}
@@ -0,0 +1,117 @@
+package mockit
+
+import (
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+type mockInterfaceTestRepository interface {
+	Get(id string) (string, error)
+	Put(id string, values ...string) error
+}
+
+func Test_MockInterface_Example_ShouldMockTheInterfaceMethods(t *testing.T) {
+	// NOTE: if this fails (i.e. the contract changed), please update the README as well
+	var reader io.Reader
+	m := MockInterface(t, &reader)
+	m.Method("Read").With(argument.Any).Return(3, io.EOF)
+
+	n, err := reader.Read(make([]byte, 5))
+
+	assert.Equal(t, 3, n)
+	assert.Equal(t, io.EOF, err)
+	m.Method(io.Reader.Read).Verify(argument.Any)
+}
+
+func Test_MockInterface_ShouldReturnZeroValuesForNonStubbedCalls(t *testing.T) {
+	var repo mockInterfaceTestRepository
+	m := MockInterface(t, &repo)
+	m.Method("Get").With("some-id").Return("some-value", nil)
+	m.Method("Put").With("some-id", "a", "b").CallRealMethod()
+
+	value, err := repo.Get("some-id")
+	assert.Equal(t, "some-value", value)
+	assert.Nil(t, err)
+
+	value, err = repo.Get("some-other-id")
+	assert.Equal(t, "", value)
+	assert.Nil(t, err)
+
+	assert.Nil(t, repo.Put("some-id", "a", "b"))
+	m.Method("Put").Verify("some-id", "a", "b")
+	m.Method("Put").VerifyNever("some-id")
+}
+
+func Test_MockInterface_ShouldVerifyCallsInOrder(t *testing.T) {
+	var repo mockInterfaceTestRepository
+	m := MockInterface(t, &repo)
+	m.Method("Put").With("some-id", argument.AnyRemaining).Return(errors.New("some-error"))
+
+	repo.Get("some-id")
+	err := repo.Put("some-id", "some-value")
+	repo.Get("some-id")
+
+	assert.Equal(t, errors.New("some-error"), err)
+	order := InOrder(t, m.Method("Get"), m.Method("Put"))
+	order.Verify(m.Method("Get"), "some-id")
+	order.Verify(m.Method("Put"), "some-id", "some-value")
+	order.Verify(m.Method("Get"), "some-id")
+}
+
+func Test_MockInterface_ShouldFailIfTheTargetIsNotAPointerToAnInterface(t *testing.T) {
+	mockT := new(testing.T)
+	var reader io.Reader
+
+	got := MockInterface(mockT, reader)
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
+
+func Test_MockInterface_ShouldBeConvertibleToOtherInterfaces(t *testing.T) {
+	var readCloser io.ReadCloser
+	m := MockInterface(t, &readCloser)
+	m.Method("Read").With(argument.Any).Return(0, io.EOF)
+	m.Method("Close").With().Return(errors.New("some-error"))
+
+	content, err := io.ReadAll(readCloser)
+	var closer interface{} = readCloser
+
+	assert.Nil(t, err)
+	assert.Empty(t, content)
+	assert.Equal(t, errors.New("some-error"), closer.(io.Closer).Close())
+	m.Method("Read").Verify(argument.Any)
+}
+
+func Test_MockInterface_ShouldCreateIndependentMocks(t *testing.T) {
+	var first, second mockInterfaceTestRepository
+	MockInterface(t, &first).Method("Get").With("some-id").Return("first", nil)
+	MockInterface(t, &second).Method("Get").With("some-id").Return("second", nil)
+
+	firstValue, _ := first.Get("some-id")
+	secondValue, _ := second.Get("some-id")
+
+	assert.Equal(t, "first", firstValue)
+	assert.Equal(t, "second", secondValue)
+	assert.NotEqual(t, first, second)
+}
+
+func Test_MockInterface_ShouldReturnZeroValuesToOtherGoroutinesIfGoroutineScoped(t *testing.T) {
+	var repo mockInterfaceTestRepository
+	m := MockInterface(t, &repo, GoroutineScoped())
+	m.Method("Get").With(argument.Any).Return("mocked", nil)
+	done := make(chan string)
+
+	value, _ := repo.Get("some-id")
+	assert.Equal(t, "mocked", value)
+	backgroundCalls <- func() {
+		value, _ := repo.Get("some-id")
+		done <- value
+	}
+	assert.Equal(t, "", <-done)
+	m.Method("Get").VerifyTimes(1, "some-id")
+}
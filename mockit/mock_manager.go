@@ -2,23 +2,29 @@ package mockit
 
 import (
 	"reflect"
+	"sync"
 	"testing"
 
 	"bou.ke/monkey"
+	"github.com/pasdam/mockit/internal/iface"
+	"github.com/pasdam/mockit/internal/symbols"
 	"github.com/pasdam/mockit/internal/utils"
 )
 
 var manager = &mockManager{
+	journal:     &callsJournal{},
 	mockedTypes: make(map[string]*mockGuard),
 }
 
 type mockManager struct {
+	journal     *callsJournal
+	lock        sync.Mutex
 	mockedTypes map[string]*mockGuard
 }
 
 type patcherProvider func(guard *mockGuard) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider)
 
-func (m *mockManager) mock(t *testing.T, any bool, instance interface{}, targetFn interface{}, provider patcherProvider) Mock {
+func (m *mockManager) mock(t *testing.T, any bool, instance interface{}, targetFn interface{}, provider patcherProvider, options []Option) Mock {
 	if targetFn == nil {
 		t.Error("Method can't be nil")
 		return nil
@@ -30,24 +36,26 @@ func (m *mockManager) mock(t *testing.T, any bool, instance interface{}, targetF
 		return nil
 	}
 
-	fullyQualifiedName := utils.MethodFullyQualifiedName(target)
+	return m.mockTarget(t, any, instance, target, utils.MethodFullyQualifiedName(target), provider, options)
+}
+
+func (m *mockManager) mockTarget(t *testing.T, any bool, instance interface{}, target reflect.Value, fullyQualifiedName string, provider patcherProvider, options []Option) Mock {
+	m.lock.Lock()
+	defer m.lock.Unlock()
 
 	guard, found := m.mockedTypes[fullyQualifiedName]
 	if !found {
 		guard = &mockGuard{
 			defaultOut:         defaultFuncOutput(target.Type()),
 			fullyQualifiedName: fullyQualifiedName,
-			mockedInstances:    make(map[interface{}]*instanceMock),
 			targetFunc:         target,
 		}
 		m.mockedTypes[fullyQualifiedName] = guard
 
+		// the lock makes sure that calls wait for the provider to be set
+		guard.lock.Lock()
 		guard.guard, guard.provider = provider(guard)(instance)
-
-		t.Cleanup(func() {
-			guard.guard.Unpatch()
-			delete(m.mockedTypes, fullyQualifiedName)
-		})
+		guard.lock.Unlock()
 	}
 
 	var key interface{}
@@ -55,39 +63,215 @@ func (m *mockManager) mock(t *testing.T, any bool, instance interface{}, targetF
 		key = instance
 	}
 
-	mock := guard.mockedInstances[key]
+	guard.lock.RLock()
+	scope := guard.scopeFor(t)
+	guard.lock.RUnlock()
+
+	created := scope == nil
+	if created {
+		// the scope is created without holding the guard's lock, as
+		// identifying the test goroutine might call mocked functions
+		scope = newMockScope(t)
+	}
+
+	guard.lock.Lock()
+	if created {
+		guard.scopes = append(guard.scopes, scope)
+	}
+	mock := scope.mockedInstances[key]
 	if mock == nil {
-		mock = &instanceMock{
-			calls:       nil,
-			defaultOut:  guard.defaultOut,
-			enabled:     true,
-			mockedCalls: &callsIndex{},
-			t:           t,
-			target:      &target,
-		}
-		guard.mockedInstances[key] = mock
+		mock = m.newInstanceMock(t, utils.MethodName(fullyQualifiedName), &target, guard.defaultOut)
+		scope.mockedInstances[key] = mock
+	}
+	guard.lock.Unlock()
+
+	if created {
+		t.Cleanup(func() {
+			m.releaseScope(guard, scope)
+		})
+	}
+
+	mock.lock.Lock()
+	defer mock.lock.Unlock()
+	for i := 0; i < len(options); i++ {
+		options[i](mock)
 	}
 
 	return mock
 }
 
-func (m *mockManager) MockFunc(t *testing.T, targetFn interface{}) Mock {
+func (m *mockManager) InOrder(t *testing.T, mocks ...Mock) InOrderVerifier {
+	verifier := &orderedVerifier{
+		journal: m.journal,
+		t:       t,
+	}
+	for i := 0; i < len(mocks); i++ {
+		instance, ok := mocks[i].(*instanceMock)
+		if !ok {
+			t.Errorf("Unsupported mock at index %d, unable to verify its calls in order", i)
+			continue
+		}
+		verifier.mocks = append(verifier.mocks, instance)
+	}
+	return verifier
+}
+
+func (m *mockManager) MockFunc(t *testing.T, targetFn interface{}, options ...Option) Mock {
 	provider := func(guard *mockGuard) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
 		return guard.patchFunc
 	}
-	return m.mock(t, false, nil, targetFn, provider)
+	return m.mock(t, false, nil, targetFn, provider, options)
+}
+
+func (m *mockManager) MockFuncByName(t *testing.T, name string, signature interface{}, options ...Option) Mock {
+	signatureType := reflect.TypeOf(signature)
+	if signatureType == nil || signatureType.Kind() != reflect.Func {
+		t.Errorf("The signature (%T) is not a function, unable to mock %s", signature, name)
+		return nil
+	}
+
+	addr, err := symbols.Lookup(name)
+	if err != nil {
+		t.Errorf("Unable to mock %s. %s", name, err.Error())
+		return nil
+	}
+
+	return m.MockFunc(t, symbols.FuncAt(addr, signatureType).Interface(), options...)
+}
+
+func (m *mockManager) MockInterface(t *testing.T, target interface{}, options ...Option) InterfaceMock {
+	targetValue := reflect.ValueOf(target)
+	if targetValue.Kind() != reflect.Ptr || targetValue.Elem().Kind() != reflect.Interface {
+		t.Errorf("The target (%T) is not a pointer to an interface, unable to mock it", target)
+		return nil
+	}
+
+	ifaceType := targetValue.Type().Elem()
+	mock := &methodsMock{
+		methods: make(map[string]*instanceMock, ifaceType.NumMethod()),
+		scope:   newMockScope(t),
+		t:       t,
+	}
+	implementations := make([]reflect.Value, ifaceType.NumMethod())
+	for i := 0; i < ifaceType.NumMethod(); i++ {
+		method := ifaceType.Method(i)
+		defaultOut := defaultFuncOutput(method.Type)
+		realTarget := reflect.MakeFunc(method.Type, func(in []reflect.Value) []reflect.Value {
+			return defaultOut
+		})
+
+		methodMock := m.newInstanceMock(t, method.Name, &realTarget, defaultOut)
+		for j := 0; j < len(options); j++ {
+			options[j](methodMock)
+		}
+		mock.methods[method.Name] = methodMock
+
+		implementations[i] = reflect.MakeFunc(iface.MethodFuncType(method.Type), func(in []reflect.Value) []reflect.Value {
+			return mock.makeCall(methodMock, in)
+		})
+	}
+
+	err := iface.Implement(targetValue, implementations)
+	if err != nil {
+		t.Errorf("Unable to mock the interface %v. %s", ifaceType, err.Error())
+		return nil
+	}
+
+	t.Cleanup(func() {
+		for _, methodMock := range mock.methods {
+			methodMock.verifyExpectations()
+			methodMock.reportUnusedStubs()
+		}
+	})
+
+	return mock
 }
 
-func (m *mockManager) MockMethod(t *testing.T, instance interface{}, targetFn interface{}) Mock {
+func (m *mockManager) MockMethod(t *testing.T, instance interface{}, targetFn interface{}, options ...Option) Mock {
 	provider := func(guard *mockGuard) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
 		return guard.patchMethod
 	}
-	return m.mock(t, false, instance, targetFn, provider)
+	return m.mock(t, false, instance, targetFn, provider, options)
 }
 
-func (m *mockManager) MockMethodForAll(t *testing.T, instance interface{}, targetFn interface{}) Mock {
+func (m *mockManager) MockMethodForAll(t *testing.T, instance interface{}, targetFn interface{}, options ...Option) Mock {
 	provider := func(guard *mockGuard) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
 		return guard.patchMethod
 	}
-	return m.mock(t, true, instance, targetFn, provider)
+	return m.mock(t, true, instance, targetFn, provider, options)
+}
+
+func (m *mockManager) MockUnexported(t *testing.T, instance interface{}, method string, signature interface{}, options ...Option) Mock {
+	if instance == nil {
+		t.Error("Instance can't be nil")
+		return nil
+	}
+
+	signatureType := reflect.TypeOf(signature)
+	if signatureType == nil || signatureType.Kind() != reflect.Func {
+		t.Errorf("The signature (%T) is not a function, unable to mock %s", signature, method)
+		return nil
+	}
+
+	receiverType, addr, err := symbols.LookupMethod(reflect.TypeOf(instance), method)
+	if err != nil {
+		t.Errorf("Unable to mock %s. %s", method, err.Error())
+		return nil
+	}
+
+	in := []reflect.Type{receiverType}
+	for i := 0; i < signatureType.NumIn(); i++ {
+		in = append(in, signatureType.In(i))
+	}
+	out := make([]reflect.Type, signatureType.NumOut())
+	for i := 0; i < len(out); i++ {
+		out[i] = signatureType.Out(i)
+	}
+	methodFunc := symbols.FuncAt(addr, reflect.FuncOf(in, out, signatureType.IsVariadic()))
+
+	receiver := reflect.ValueOf(instance)
+	if receiver.Type() != receiverType {
+		receiver = receiver.Elem()
+	}
+
+	provider := func(guard *mockGuard) func(instance interface{}) (*monkey.PatchGuard, callMetadataProvider) {
+		return guard.patchUnexportedMethod(methodFunc)
+	}
+	return m.mockTarget(t, false, receiver.Interface(), bindReceiver(methodFunc, receiver, signatureType), symbols.MethodSymbol(receiverType, method), provider, options)
+}
+
+func (m *mockManager) releaseScope(guard *mockGuard, scope *mockScope) {
+	mocks := guard.mocks(scope)
+	for i := 0; i < len(mocks); i++ {
+		mocks[i].verifyExpectations()
+		mocks[i].reportUnusedStubs()
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	// the function is unpatched only when the last test using it completes
+	if guard.removeScope(scope) == 0 {
+		guard.unpatch()
+		delete(m.mockedTypes, guard.fullyQualifiedName)
+	}
+}
+
+func (m *mockManager) newInstanceMock(t *testing.T, name string, target *reflect.Value, defaultOut []reflect.Value) *instanceMock {
+	mock := &instanceMock{
+		calls:       nil,
+		defaultOut:  defaultOut,
+		enabled:     true,
+		journal:     m.journal,
+		mockedCalls: &callsIndex{},
+		name:        name,
+		t:           t,
+		target:      target,
+	}
+
+	t.Cleanup(func() {
+		m.journal.Remove(mock)
+	})
+
+	return mock
 }
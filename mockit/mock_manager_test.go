@@ -20,7 +20,7 @@ func Test_mockManager_mock_shouldFailTestIfTargetFunctionIsNil(t *testing.T) {
 	mockT := new(testing.T)
 	instance := "some-instance"
 
-	manager.mock(mockT, false, instance, nil, emptyProvider)
+	manager.mock(mockT, false, instance, nil, emptyProvider, nil)
 
 	assert.True(t, mockT.Failed())
 }
@@ -32,7 +32,37 @@ func Test_mockManager_mock_shouldFailTestIfTargetIsNotAFunction(t *testing.T) {
 	mockT := new(testing.T)
 	instance := "some-instance"
 
-	manager.mock(mockT, false, instance, "some-non-func-target", emptyProvider)
+	manager.mock(mockT, false, instance, "some-non-func-target", emptyProvider, nil)
+
+	assert.True(t, mockT.Failed())
+}
+
+func Test_mockManager_InOrder(t *testing.T) {
+	manager := mockManager{
+		journal:     &callsJournal{},
+		mockedTypes: make(map[string]*mockGuard),
+	}
+	mock1 := &instanceMock{}
+	mock2 := &instanceMock{}
+	mockT := new(testing.T)
+
+	got := manager.InOrder(mockT, mock1, mock2).(*orderedVerifier)
+
+	assert.False(t, mockT.Failed())
+	assert.Equal(t, manager.journal, got.journal)
+	assert.Equal(t, []*instanceMock{mock1, mock2}, got.mocks)
+	assert.Equal(t, uint64(0), got.next)
+	assert.Equal(t, mockT, got.t)
+}
+
+func Test_mockManager_InOrder_shouldFailTestIfAMockIsNotSupported(t *testing.T) {
+	manager := mockManager{
+		journal:     &callsJournal{},
+		mockedTypes: make(map[string]*mockGuard),
+	}
+	mockT := new(testing.T)
+
+	manager.InOrder(mockT, nil)
 
 	assert.True(t, mockT.Failed())
 }
@@ -5,6 +5,6 @@ import (
 )
 
 // MockMethod creates a new Mock to mock an instance method
-func MockMethod(t *testing.T, instance interface{}, method interface{}) Mock {
-	return manager.MockMethod(t, instance, method)
+func MockMethod(t *testing.T, instance interface{}, method interface{}, options ...Option) Mock {
+	return manager.MockMethod(t, instance, method, options...)
 }
@@ -6,6 +6,6 @@ import (
 
 // MockMethodForAll creates a new Mock to mock the method for any instance of
 // the specified type
-func MockMethodForAll(t *testing.T, instance interface{}, method interface{}) Mock {
-	return manager.MockMethodForAll(t, instance, method)
+func MockMethodForAll(t *testing.T, instance interface{}, method interface{}, options ...Option) Mock {
+	return manager.MockMethodForAll(t, instance, method, options...)
 }
@@ -24,3 +24,13 @@ func Test_MockMethodForAll_ShouldMockAllInstances(t *testing.T) {
 	err2 := errors.New("some-other-error")
 	assert.Equal(t, "some-mocked-value", err2.Error())
 }
+
+func Test_MockMethodForAll_ShouldCallTheRealMethodOfTheReceiver(t *testing.T) {
+	err1 := errors.New("some-real-error")
+	m := MockMethodForAll(t, err1, err1.Error)
+	m.With().CallRealMethod()
+
+	err2 := errors.New("some-other-error")
+	assert.Equal(t, "some-other-error", err2.Error())
+	assert.Equal(t, "some-real-error", err1.Error())
+}
@@ -2,6 +2,8 @@ package mockit
 
 import (
 	"errors"
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -24,3 +26,21 @@ func Test_MockMethod_ShouldMockOnlyTheSpecifiedInstance(t *testing.T) {
 	err2 := errors.New("some-other-error")
 	assert.Equal(t, "some-other-error", err2.Error())
 }
+
+func Test_MockMethod_ShouldBeSafeForConcurrentMocksAndCalls(t *testing.T) {
+	wg := sync.WaitGroup{}
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			err := errors.New("some-error")
+			m := MockMethod(t, err, err.Error)
+			m.With().Return(fmt.Sprintf("mocked-%d", i))
+			for j := 0; j < 50; j++ {
+				assert.Equal(t, fmt.Sprintf("mocked-%d", i), err.Error())
+			}
+			m.VerifyTimes(50)
+		}(i)
+	}
+	wg.Wait()
+}
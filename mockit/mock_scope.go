@@ -0,0 +1,49 @@
+package mockit
+
+import (
+	"testing"
+	"unsafe"
+
+	"github.com/pasdam/mockit/internal/goroutines"
+)
+
+// mockScope contains the mocks of a function created by a test
+type mockScope struct {
+	goroutine       uint64
+	mockedInstances map[interface{}]*instanceMock
+	t               *testing.T
+}
+
+func newMockScope(t *testing.T) *mockScope {
+	// the test goroutine runs testing.tRunner, that has the test as first
+	// argument
+	goroutine, found := goroutines.FindByFrame("testing.tRunner(" + goroutines.FormatPointer(uintptr(unsafe.Pointer(t))) + ",")
+	if !found {
+		goroutine = goroutines.Current()
+	}
+
+	return &mockScope{
+		goroutine:       goroutine,
+		mockedInstances: make(map[interface{}]*instanceMock),
+		t:               t,
+	}
+}
+
+// mockFor returns the mock of the specified instance, or the one for all the
+// instances if not found
+func (s *mockScope) mockFor(instance interface{}) (*instanceMock, bool) {
+	mock, found := s.mockedInstances[instance]
+	if !found {
+		mock, found = s.mockedInstances[nil]
+	}
+	return mock, found
+}
+
+// isAncestorOfCurrent returns true if the test goroutine is the current one,
+// or one of its ancestors
+func (s *mockScope) isAncestorOfCurrent() bool {
+	_, found := goroutines.FindAncestor(func(id uint64) bool {
+		return id == s.goroutine
+	})
+	return found
+}
@@ -0,0 +1,44 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/pasdam/mockit/internal/goroutines"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_newMockScope(t *testing.T) {
+	done := make(chan *mockScope)
+	go func() {
+		done <- newMockScope(t)
+	}()
+
+	got := <-done
+
+	assert.Equal(t, goroutines.Current(), got.goroutine)
+	assert.Equal(t, t, got.t)
+	assert.NotNil(t, got.mockedInstances)
+}
+
+func Test_mockScope_mockFor(t *testing.T) {
+	instanceMock1 := &instanceMock{}
+	instanceMock2 := &instanceMock{}
+	scope := &mockScope{
+		mockedInstances: map[interface{}]*instanceMock{
+			"some-instance": instanceMock1,
+		},
+	}
+
+	got, found := scope.mockFor("some-instance")
+	assert.True(t, found)
+	assert.Equal(t, instanceMock1, got)
+
+	got, found = scope.mockFor("some-other-instance")
+	assert.False(t, found)
+	assert.Nil(t, got)
+
+	scope.mockedInstances[nil] = instanceMock2
+	got, found = scope.mockFor("some-other-instance")
+	assert.True(t, found)
+	assert.Equal(t, instanceMock2, got)
+}
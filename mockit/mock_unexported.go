@@ -0,0 +1,13 @@
+package mockit
+
+import (
+	"testing"
+)
+
+// MockUnexported creates a new Mock to mock an unexported method of an
+// instance. As the name doesn't carry any type information, the signature of
+// the method, without the receiver, must be specified, i.e.
+// (func([]byte) uint32)(nil).
+func MockUnexported(t *testing.T, instance interface{}, method string, signature interface{}, options ...Option) Mock {
+	return manager.MockUnexported(t, instance, method, signature, options...)
+}
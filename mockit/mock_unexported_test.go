@@ -0,0 +1,104 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type unexportedTestType struct {
+	prefix string
+}
+
+//go:noinline
+func (u *unexportedTestType) computeChecksum(data []byte) uint32 {
+	result := uint32(len(u.prefix))
+	for _, b := range data {
+		result += uint32(b)
+	}
+	return result
+}
+
+//go:noinline
+func (u unexportedTestType) describe(values ...string) string {
+	result := u.prefix
+	for _, v := range values {
+		result += "-" + v
+	}
+	return result
+}
+
+func Test_MockUnexported_ShouldMockOnlyTheSpecifiedInstance(t *testing.T) {
+	instance1 := &unexportedTestType{prefix: "a"}
+	instance2 := &unexportedTestType{prefix: "b"}
+
+	m := MockUnexported(t, instance1, "computeChecksum", (func([]byte) uint32)(nil))
+	m.With([]byte{1, 2}).Return(uint32(42))
+
+	assert.Equal(t, uint32(42), instance1.computeChecksum([]byte{1, 2}))
+	assert.Equal(t, uint32(0), instance1.computeChecksum([]byte{3}))
+	assert.Equal(t, uint32(4), instance2.computeChecksum([]byte{1, 2}))
+	m.Verify([]byte{1, 2})
+	m.Verify([]byte{3})
+}
+
+func Test_MockUnexported_ShouldCallTheRealMethod(t *testing.T) {
+	instance := &unexportedTestType{prefix: "a"}
+
+	m := MockUnexported(t, instance, "computeChecksum", (func([]byte) uint32)(nil))
+	m.With([]byte{1, 2}).CallRealMethod()
+
+	assert.Equal(t, uint32(4), instance.computeChecksum([]byte{1, 2}))
+}
+
+func Test_MockUnexported_ShouldMockValueReceivers(t *testing.T) {
+	instance := unexportedTestType{prefix: "a"}
+
+	m := MockUnexported(t, &instance, "describe", (func(...string) string)(nil))
+	m.With("x", "y").Return("mocked")
+	m.With("z").CallRealMethod()
+
+	assert.Equal(t, "mocked", instance.describe("x", "y"))
+	assert.Equal(t, "a-z", instance.describe("z"))
+	assert.Equal(t, "b-x-y", unexportedTestType{prefix: "b"}.describe("x", "y"))
+}
+
+func Test_MockUnexported_ShouldRemoveTheMockWhenTheTestCompletes(t *testing.T) {
+	instance := &unexportedTestType{prefix: "a"}
+
+	t.Run("", func(t *testing.T) {
+		m := MockUnexported(t, instance, "computeChecksum", (func([]byte) uint32)(nil))
+		m.With([]byte{1}).Return(uint32(42))
+
+		assert.Equal(t, uint32(42), instance.computeChecksum([]byte{1}))
+	})
+
+	assert.Equal(t, uint32(2), instance.computeChecksum([]byte{1}))
+}
+
+func Test_MockUnexported_ShouldFailIfTheMethodIsNotFound(t *testing.T) {
+	mockT := new(testing.T)
+
+	got := MockUnexported(mockT, &unexportedTestType{}, "notExistingMethod", (func())(nil))
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
+
+func Test_MockUnexported_ShouldFailIfTheInstanceIsNil(t *testing.T) {
+	mockT := new(testing.T)
+
+	got := MockUnexported(mockT, nil, "computeChecksum", (func([]byte) uint32)(nil))
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
+
+func Test_MockUnexported_ShouldFailIfTheSignatureIsNotAFunction(t *testing.T) {
+	mockT := new(testing.T)
+
+	got := MockUnexported(mockT, &unexportedTestType{}, "computeChecksum", "some-signature")
+
+	assert.Nil(t, got)
+	assert.True(t, mockT.Failed())
+}
@@ -0,0 +1,22 @@
+package mockit
+
+import "reflect"
+
+type mockedCall struct {
+	answers []answer
+	hits    int
+	in      []reflect.Value
+	times   *int
+}
+
+// next returns the answer for the current call, and counts it as used; the
+// answers are used in the order they were configured, once all of them are
+// used the last one is repeated
+func (c *mockedCall) next() answer {
+	index := c.hits
+	if index >= len(c.answers) {
+		index = len(c.answers) - 1
+	}
+	c.hits++
+	return c.answers[index]
+}
@@ -0,0 +1,24 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_mockedCall_next(t *testing.T) {
+	call := &mockedCall{
+		answers: []answer{
+			fixedAnswer([]reflect.Value{reflect.ValueOf("first")}),
+			fixedAnswer([]reflect.Value{reflect.ValueOf("second")}),
+		},
+	}
+
+	assert.Equal(t, "first", call.next()(nil)[0].Interface())
+	assert.Equal(t, 1, call.hits)
+	assert.Equal(t, "second", call.next()(nil)[0].Interface())
+	assert.Equal(t, 2, call.hits)
+	assert.Equal(t, "second", call.next()(nil)[0].Interface())
+	assert.Equal(t, 3, call.hits)
+}
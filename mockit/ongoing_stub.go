@@ -0,0 +1,10 @@
+package mockit
+
+// OngoingStub contains methods to configure the answers for consecutive calls
+// of a stub
+type OngoingStub interface {
+
+	// Then configures the answer for the next call; once all the configured
+	// answers are used, the last one is repeated
+	Then() Stub
+}
@@ -0,0 +1,4 @@
+package mockit
+
+// Option is used to configure the behaviour of a mock
+type Option func(mock *instanceMock)
@@ -0,0 +1,58 @@
+package mockit
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/pasdam/mockit/internal/format"
+)
+
+type orderedVerifier struct {
+	journal *callsJournal
+	mocks   []*instanceMock
+	next    uint64
+	t       *testing.T
+}
+
+func (v *orderedVerifier) Verify(mock Mock, in ...interface{}) {
+	instance, ok := mock.(*instanceMock)
+	if !ok || !v.contains(instance) {
+		v.t.Error("The mock is not part of the in order verification")
+		return
+	}
+
+	inValues := argumentsToValues(in, instance.target.Type())
+	entry := v.journal.Find(v.next, instance, inValues)
+	if entry != nil {
+		v.next = entry.seq + 1
+		return
+	}
+
+	builder := strings.Builder{}
+	builder.WriteString("Expected call in order: ")
+	builder.WriteString(format.PrintCall(instance.name, inValues))
+	timeline := v.journal.Timeline(v.mocks)
+	if len(timeline) > 0 {
+		builder.WriteString("; but it recorded the following timeline instead:")
+		for i := 0; i < len(timeline); i++ {
+			builder.WriteString(fmt.Sprintf("\n\t%d: %s", timeline[i].seq, format.PrintCall(timeline[i].mock.name, timeline[i].in)))
+			if timeline[i].seq+1 == v.next {
+				builder.WriteString(" (last verified)")
+			}
+		}
+
+	} else {
+		builder.WriteString("; but no call was recorded")
+	}
+	v.t.Error(builder.String())
+}
+
+func (v *orderedVerifier) contains(mock *instanceMock) bool {
+	for i := 0; i < len(v.mocks); i++ {
+		if v.mocks[i] == mock {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package mockit
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_orderedVerifier_Verify(t *testing.T) {
+	baseTarget := reflect.ValueOf(filepath.Base)
+	extTarget := reflect.ValueOf(filepath.Ext)
+	baseMock := &instanceMock{target: &baseTarget}
+	extMock := &instanceMock{target: &extTarget}
+	otherMock := &instanceMock{target: &baseTarget}
+	journal := &callsJournal{}
+	journal.Record(baseMock, []reflect.Value{reflect.ValueOf("some-arg")})
+	journal.Record(extMock, []reflect.Value{reflect.ValueOf("some-arg")})
+	journal.Record(baseMock, []reflect.Value{reflect.ValueOf("some-other-arg")})
+
+	type call struct {
+		mock Mock
+		in   []interface{}
+	}
+	tests := []struct {
+		name       string
+		calls      []call
+		shouldFail bool
+	}{
+		{
+			name: "Should succeed if calls happened in order",
+			calls: []call{
+				{mock: baseMock, in: []interface{}{"some-arg"}},
+				{mock: extMock, in: []interface{}{"some-arg"}},
+				{mock: baseMock, in: []interface{}{"some-other-arg"}},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Should succeed if skipping some calls",
+			calls: []call{
+				{mock: baseMock, in: []interface{}{"some-arg"}},
+				{mock: baseMock, in: []interface{}{"some-other-arg"}},
+			},
+			shouldFail: false,
+		},
+		{
+			name: "Should fail if calls happened in a different order",
+			calls: []call{
+				{mock: extMock, in: []interface{}{"some-arg"}},
+				{mock: baseMock, in: []interface{}{"some-arg"}},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Should fail if a call didn't happen",
+			calls: []call{
+				{mock: extMock, in: []interface{}{"some-other-arg"}},
+			},
+			shouldFail: true,
+		},
+		{
+			name: "Should fail if the mock is not part of the verification",
+			calls: []call{
+				{mock: otherMock, in: []interface{}{"some-arg"}},
+			},
+			shouldFail: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockT := new(testing.T)
+			v := &orderedVerifier{
+				journal: journal,
+				mocks:   []*instanceMock{baseMock, extMock},
+				t:       mockT,
+			}
+
+			for i := 0; i < len(tt.calls); i++ {
+				v.Verify(tt.calls[i].mock, tt.calls[i].in...)
+			}
+
+			assert.Equal(t, tt.shouldFail, mockT.Failed())
+		})
+	}
+}
+
+func Test_orderedVerifier_Verify_shouldFailIfNoCallWasRecorded(t *testing.T) {
+	target := reflect.ValueOf(filepath.Base)
+	mock := &instanceMock{target: &target}
+	mockT := new(testing.T)
+	v := &orderedVerifier{
+		journal: &callsJournal{},
+		mocks:   []*instanceMock{mock},
+		t:       mockT,
+	}
+
+	v.Verify(mock, "some-arg")
+
+	assert.True(t, mockT.Failed())
+}
@@ -0,0 +1,9 @@
+package mockit
+
+import "reflect"
+
+func panicAnswer(value interface{}) answer {
+	return func(in []reflect.Value) []reflect.Value {
+		panic(value)
+	}
+}
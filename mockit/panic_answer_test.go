@@ -0,0 +1,19 @@
+package mockit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_panicAnswer(t *testing.T) {
+	err := errors.New("some-error")
+
+	assert.PanicsWithValue(t, "some-value", func() {
+		panicAnswer("some-value")(nil)
+	})
+	assert.PanicsWithError(t, "some-error", func() {
+		panicAnswer(err)(nil)
+	})
+}
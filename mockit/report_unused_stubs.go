@@ -0,0 +1,9 @@
+package mockit
+
+// ReportUnusedStubs configures how the mock reports, at the end of the test,
+// the stubs that were never used
+func ReportUnusedStubs(level UnusedStubsLevel) Option {
+	return func(mock *instanceMock) {
+		mock.unusedStubs = level
+	}
+}
@@ -0,0 +1,15 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_ReportUnusedStubs(t *testing.T) {
+	m := &instanceMock{}
+
+	ReportUnusedStubs(UnusedStubsFail)(m)
+
+	assert.Equal(t, UnusedStubsFail, m.unusedStubs)
+}
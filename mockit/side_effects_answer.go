@@ -0,0 +1,20 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+)
+
+// sideEffectsAnswer applies the setters to the arguments and then computes the
+// outputs with the specified answer
+func sideEffectsAnswer(t *testing.T, setters []*argSetter, a answer) answer {
+	return func(in []reflect.Value) []reflect.Value {
+		for _, setter := range setters {
+			err := setter.apply(in)
+			if err != nil {
+				t.Errorf("Unable to set the argument. %s", err.Error())
+			}
+		}
+		return a(in)
+	}
+}
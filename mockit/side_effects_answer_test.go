@@ -0,0 +1,36 @@
+package mockit
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_sideEffectsAnswer(t *testing.T) {
+	t.Run("Should apply the setters before computing the outputs", func(t *testing.T) {
+		mockT := new(testing.T)
+		value := 0
+		setters := []*argSetter{{index: 0, value: 3}}
+		var got int
+
+		out := sideEffectsAnswer(mockT, setters, func(in []reflect.Value) []reflect.Value {
+			got = value
+			return []reflect.Value{}
+		})([]reflect.Value{reflect.ValueOf(&value)})
+
+		assert.False(t, mockT.Failed())
+		assert.Equal(t, 3, got)
+		assert.Equal(t, []reflect.Value{}, out)
+	})
+
+	t.Run("Should fail the test and compute the outputs if a setter fails", func(t *testing.T) {
+		mockT := new(testing.T)
+		setters := []*argSetter{{index: 1, value: 3}}
+
+		out := sideEffectsAnswer(mockT, setters, fixedAnswer([]reflect.Value{}))([]reflect.Value{})
+
+		assert.True(t, mockT.Failed())
+		assert.Equal(t, []reflect.Value{}, out)
+	})
+}
@@ -0,0 +1,9 @@
+package mockit
+
+// Strict makes the mock fail the test when it receives a call that doesn't
+// match any stub, instead of silently returning the zero values
+func Strict() Option {
+	return func(mock *instanceMock) {
+		mock.strict = true
+	}
+}
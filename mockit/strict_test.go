@@ -0,0 +1,15 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Strict(t *testing.T) {
+	m := &instanceMock{}
+
+	Strict()(m)
+
+	assert.True(t, m.strict)
+}
@@ -1,14 +1,67 @@
 package mockit
 
+import "time"
+
 // Stub contains method to mock a specific method
 type Stub interface {
 
+	// BlockUntil makes sure the mock to block the call until the channel is
+	// closed (or receives a value), before computing the outputs; it must be
+	// followed by another method (i.e. Return) to complete the stub
+	BlockUntil(ch <-chan struct{}) Stub
+
+	// BlockUntilContext is like BlockUntil, but if the context (that must be
+	// the first argument) is done before, the mock returns the context error
+	// (in the last error output) and zero values as the other outputs
+	BlockUntilContext(ch <-chan struct{}) Stub
+
 	// CallRealMethod makes sure that the mock perform a call to the real method
-	CallRealMethod()
+	CallRealMethod() OngoingStub
+
+	// Delay makes sure the mock to sleep for the specified duration, before
+	// computing the outputs; it must be followed by another method (i.e.
+	// Return) to complete the stub
+	Delay(d time.Duration) Stub
+
+	// DelayContext is like Delay, but if the context (that must be the first
+	// argument) is done before, the mock returns the context error (in the
+	// last error output) and zero values as the other outputs
+	DelayContext(d time.Duration) Stub
+
+	// DoAndReturn makes sure the mock to call the specified function, with the
+	// actual arguments, and return its outputs; the function must have the
+	// same signature of the mocked one
+	DoAndReturn(fn interface{}) OngoingStub
 
-	// Return makes sure the mock to return the specified values
-	Return(values ...interface{})
+	// Panic makes sure the mock to panic with the specified value; the call is
+	// recorded anyway
+	Panic(value interface{}) OngoingStub
+
+	// PanicWithError makes sure the mock to panic with the specified error; the
+	// call is recorded anyway
+	PanicWithError(err error) OngoingStub
+
+	// Return makes sure the mock to return the specified values, an Arg
+	// placeholder is replaced with the actual argument
+	Return(values ...interface{}) OngoingStub
+
+	// ReturnArg makes sure the mock to return the argument at the specified
+	// index as first output, and the zero values as the others
+	ReturnArg(index int) OngoingStub
 
 	// ReturnDefaults makes sure the mock to return the default outputs (zero values)
-	ReturnDefaults()
+	ReturnDefaults() OngoingStub
+
+	// SetArg makes sure the mock to write the specified value through the
+	// argument at the specified index, before computing the outputs; the
+	// argument must be a pointer (the value is assigned to the pointed
+	// variable), a slice (the elements are copied) or a map (the entries are
+	// set). It can be called multiple times, and must be followed by another
+	// method (i.e. Return) to complete the stub
+	SetArg(index int, value interface{}) Stub
+
+	// Times declares that the stub is expected to be used exactly the
+	// specified number of times, the expectation is automatically verified at
+	// the end of the test
+	Times(times int) Stub
 }
@@ -1,36 +1,219 @@
 package mockit
 
-import "reflect"
+import (
+	"reflect"
+	"time"
+)
 
 type stubBuilder struct {
 	args      []reflect.Value
+	call      *mockedCall
 	mock      *instanceMock
 	completed bool
+	delays    []*delay
+	setters   []*argSetter
+	times     *int
 }
 
-func (b *stubBuilder) CallRealMethod() {
-	b.assertUncompleted()
+func (b *stubBuilder) BlockUntil(ch <-chan struct{}) Stub {
+	if b.assertUncompleted() {
+		b.delays = append(b.delays, blockUntilDelay(ch, -1))
+	}
+
+	return b
+}
+
+func (b *stubBuilder) BlockUntilContext(ch <-chan struct{}) Stub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	errIndex, err := contextErrorIndex(b.mock.target.Type())
+	if err != nil {
+		b.mock.t.Errorf("Invalid stub. %s", err.Error())
+		return b
+	}
+	b.delays = append(b.delays, blockUntilDelay(ch, errIndex))
+
+	return b
+}
+
+func (b *stubBuilder) CallRealMethod() OngoingStub {
+	if b.assertUncompleted() {
+		b.addAnswer(fixedAnswer(nil))
+	}
+
+	return b
+}
+
+func (b *stubBuilder) Delay(d time.Duration) Stub {
+	if b.assertUncompleted() {
+		b.delays = append(b.delays, durationDelay(d, -1))
+	}
+
+	return b
+}
+
+func (b *stubBuilder) DelayContext(d time.Duration) Stub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	errIndex, err := contextErrorIndex(b.mock.target.Type())
+	if err != nil {
+		b.mock.t.Errorf("Invalid stub. %s", err.Error())
+		return b
+	}
+	b.delays = append(b.delays, durationDelay(d, errIndex))
+
+	return b
+}
+
+func (b *stubBuilder) DoAndReturn(fn interface{}) OngoingStub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	typeOf := b.mock.target.Type()
+	fnValue := reflect.ValueOf(fn)
+	if fn == nil || fnValue.Kind() != reflect.Func || !fnValue.Type().AssignableTo(typeOf) {
+		b.mock.t.Errorf("Invalid callback. Expected a function of type %v, actual %T", typeOf, fn)
+		return b
+	}
+
+	b.addAnswer(callbackAnswer(fnValue))
+
+	return b
+}
+
+func (b *stubBuilder) Panic(value interface{}) OngoingStub {
+	if b.assertUncompleted() {
+		b.addAnswer(panicAnswer(value))
+	}
+
+	return b
+}
 
-	b.mock.mockedCalls.Add(b.args, nil)
+func (b *stubBuilder) PanicWithError(err error) OngoingStub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	if err == nil {
+		b.mock.t.Error("Invalid error, it can't be nil")
+		return b
+	}
+
+	b.addAnswer(panicAnswer(err))
+
+	return b
+}
+
+func (b *stubBuilder) Return(values ...interface{}) OngoingStub {
+	if b.assertUncompleted() {
+		out, placeholders := convertToOutputsAndVerifies(b.mock.t, values, b.mock.target.Type())
+		if len(placeholders) > 0 {
+			b.addAnswer(argsAnswer(out, placeholders))
+		} else {
+			b.addAnswer(fixedAnswer(out))
+		}
+	}
+
+	return b
 }
 
-func (b *stubBuilder) Return(values ...interface{}) {
-	b.assertUncompleted()
+func (b *stubBuilder) ReturnArg(index int) OngoingStub {
+	if !b.assertUncompleted() {
+		return b
+	}
 
 	typeOf := b.mock.target.Type()
-	out := convertToValuesAndVerifies(b.mock.t, values, typeOf.NumOut(), typeOf.Out)
+	if typeOf.NumOut() == 0 {
+		b.mock.t.Error("Invalid arguments. The function has no outputs")
+		return b
+	}
+
+	values := make([]interface{}, typeOf.NumOut())
+	values[0] = Arg(index)
 
-	b.mock.mockedCalls.Add(b.args, out)
+	return b.Return(values...)
 }
 
-func (b *stubBuilder) ReturnDefaults() {
-	b.assertUncompleted()
+func (b *stubBuilder) ReturnDefaults() OngoingStub {
+	if b.assertUncompleted() {
+		b.addAnswer(fixedAnswer(b.mock.defaultOut))
+	}
 
-	b.mock.mockedCalls.Add(b.args, b.mock.defaultOut)
+	return b
+}
+
+func (b *stubBuilder) SetArg(index int, value interface{}) Stub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	setter, err := newArgSetter(b.mock.target.Type(), index, value)
+	if err != nil {
+		b.mock.t.Errorf("Invalid argument. %s", err.Error())
+		return b
+	}
+	b.setters = append(b.setters, setter)
+
+	return b
+}
+
+func (b *stubBuilder) Then() Stub {
+	b.completed = false
+
+	return b
+}
+
+func (b *stubBuilder) Times(times int) Stub {
+	if !b.assertUncompleted() {
+		return b
+	}
+
+	if times < 0 {
+		b.mock.t.Errorf("Invalid number of times (%d), it can't be negative", times)
+		return b
+	}
+	b.times = &times
+	if b.call != nil {
+		b.mock.mockedCalls.SetTimes(b.call, b.times)
+	}
+
+	return b
+}
+
+func (b *stubBuilder) addAnswer(a answer) {
+	if len(b.setters) > 0 {
+		a = sideEffectsAnswer(b.mock.t, b.setters, a)
+		b.setters = nil
+	}
+	if len(b.delays) > 0 {
+		a = delayAnswer(b.delays, b.mock.defaultOut, a)
+		b.delays = nil
+	}
+
+	if b.call == nil {
+		// the call is published with its first answer, as concurrent calls
+		// might use it as soon as it is added
+		b.call = &mockedCall{
+			answers: []answer{a},
+			in:      b.args,
+			times:   b.times,
+		}
+		b.mock.mockedCalls.Add(b.call)
+	} else {
+		b.mock.mockedCalls.AddAnswer(b.call, a)
+	}
+	b.completed = true
 }
 
-func (b *stubBuilder) assertUncompleted() {
+func (b *stubBuilder) assertUncompleted() bool {
 	if b.completed {
 		b.mock.t.Error("The stub is already configured, please create a new one")
+		return false
 	}
+	return true
 }
@@ -1,9 +1,13 @@
 package mockit
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -54,11 +58,11 @@ func Test_stubBuilder_CallRealMethod(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					nil,
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer(nil)},
+					},
 				},
 			},
 		},
@@ -71,11 +75,11 @@ func Test_stubBuilder_CallRealMethod(t *testing.T) {
 					calls:      nil,
 					enabled:    false,
 					mockedCalls: &callsIndex{
-						in: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-input")},
-						},
-						out: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-output")},
+						calls: []*mockedCall{
+							{
+								in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+								answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+							},
 						},
 					},
 					target: nil,
@@ -84,13 +88,15 @@ func Test_stubBuilder_CallRealMethod(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-input")},
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-output")},
-					nil,
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer(nil)},
+					},
 				},
 			},
 		},
@@ -107,19 +113,7 @@ func Test_stubBuilder_CallRealMethod(t *testing.T) {
 			if tt.shouldSucceed {
 				assert.False(t, b.mock.t.Failed())
 
-				assert.Equal(t, len(tt.wantMocks.in), len(b.mock.mockedCalls.in))
-				for i := 0; i < len(b.mock.mockedCalls.in); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.in[i]); j++ {
-						assert.Equal(t, tt.wantMocks.in[i][j].Interface(), b.mock.mockedCalls.in[i][j].Interface())
-					}
-				}
-
-				assert.Equal(t, len(tt.wantMocks.out), len(b.mock.mockedCalls.out))
-				for i := 0; i < len(b.mock.mockedCalls.out); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.out[i]); j++ {
-						assert.Equal(t, tt.wantMocks.out[i][j].Interface(), b.mock.mockedCalls.out[i][j].Interface())
-					}
-				}
+				assertCallsIndexEqual(t, tt.wantMocks, b.mock.mockedCalls)
 
 			} else {
 				assert.True(t, b.mock.t.Failed())
@@ -182,11 +176,11 @@ func Test_stubBuilder_Return(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-success-value"), reflect.ValueOf(100)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-success-value"), reflect.ValueOf(100)})},
+					},
 				},
 			},
 		},
@@ -199,11 +193,11 @@ func Test_stubBuilder_Return(t *testing.T) {
 					calls:      nil,
 					enabled:    false,
 					mockedCalls: &callsIndex{
-						in: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-input")},
-						},
-						out: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-output")},
+						calls: []*mockedCall{
+							{
+								in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+								answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+							},
 						},
 					},
 					target: &target,
@@ -215,13 +209,15 @@ func Test_stubBuilder_Return(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-input")},
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-output")},
-					{reflect.ValueOf("some-other-success-value"), reflect.ValueOf(200)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-other-success-value"), reflect.ValueOf(200)})},
+					},
 				},
 			},
 		},
@@ -238,19 +234,7 @@ func Test_stubBuilder_Return(t *testing.T) {
 			if tt.shouldSucceed {
 				assert.False(t, b.mock.t.Failed())
 
-				assert.Equal(t, len(tt.wantMocks.in), len(b.mock.mockedCalls.in))
-				for i := 0; i < len(b.mock.mockedCalls.in); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.in[i]); j++ {
-						assert.Equal(t, tt.wantMocks.in[i][j].Interface(), b.mock.mockedCalls.in[i][j].Interface())
-					}
-				}
-
-				assert.Equal(t, len(tt.wantMocks.out), len(b.mock.mockedCalls.out))
-				for i := 0; i < len(b.mock.mockedCalls.out); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.out[i]); j++ {
-						assert.Equal(t, tt.wantMocks.out[i][j].Interface(), b.mock.mockedCalls.out[i][j].Interface())
-					}
-				}
+				assertCallsIndexEqual(t, tt.wantMocks, b.mock.mockedCalls)
 
 			} else {
 				assert.True(t, b.mock.t.Failed())
@@ -303,11 +287,11 @@ func Test_stubBuilder_ReturnDefaults(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-success-value"), reflect.ValueOf(300)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-success-value"), reflect.ValueOf(300)})},
+					},
 				},
 			},
 		},
@@ -320,11 +304,11 @@ func Test_stubBuilder_ReturnDefaults(t *testing.T) {
 					calls:      nil,
 					enabled:    false,
 					mockedCalls: &callsIndex{
-						in: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-input")},
-						},
-						out: [][]reflect.Value{
-							{reflect.ValueOf("some-previous-output")},
+						calls: []*mockedCall{
+							{
+								in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+								answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+							},
 						},
 					},
 					target: nil,
@@ -333,13 +317,15 @@ func Test_stubBuilder_ReturnDefaults(t *testing.T) {
 			},
 			shouldSucceed: true,
 			wantMocks: &callsIndex{
-				in: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-input")},
-					{reflect.ValueOf("some-value")},
-				},
-				out: [][]reflect.Value{
-					{reflect.ValueOf("some-previous-output")},
-					{reflect.ValueOf("some-other-success-value"), reflect.ValueOf(400)},
+				calls: []*mockedCall{
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-previous-input")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-previous-output")})},
+					},
+					{
+						in:      []reflect.Value{reflect.ValueOf("some-value")},
+						answers: []answer{fixedAnswer([]reflect.Value{reflect.ValueOf("some-other-success-value"), reflect.ValueOf(400)})},
+					},
 				},
 			},
 		},
@@ -356,19 +342,401 @@ func Test_stubBuilder_ReturnDefaults(t *testing.T) {
 			if tt.shouldSucceed {
 				assert.False(t, b.mock.t.Failed())
 
-				assert.Equal(t, len(tt.wantMocks.in), len(b.mock.mockedCalls.in))
-				for i := 0; i < len(b.mock.mockedCalls.in); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.in[i]); j++ {
-						assert.Equal(t, tt.wantMocks.in[i][j].Interface(), b.mock.mockedCalls.in[i][j].Interface())
-					}
-				}
+				assertCallsIndexEqual(t, tt.wantMocks, b.mock.mockedCalls)
+
+			} else {
+				assert.True(t, b.mock.t.Failed())
+			}
+		})
+	}
+}
+
+func Test_stubBuilder_Times(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	tests := []struct {
+		name          string
+		completed     bool
+		times         int
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			completed:     true,
+			times:         1,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the number of times is negative",
+			completed:     false,
+			times:         -1,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should set the expected number of times",
+			completed:     false,
+			times:         2,
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			got := b.Times(tt.times)
+
+			assert.Equal(t, b, got)
+			if tt.shouldSucceed {
+				assert.False(t, b.mock.t.Failed())
+				assert.Equal(t, tt.times, *b.times)
+
+				b.Return("some-value", 1)
+
+				assert.Equal(t, tt.times, *b.mock.mockedCalls.calls[0].times)
+
+			} else {
+				assert.True(t, b.mock.t.Failed())
+			}
+		})
+	}
+}
+
+func Test_stubBuilder_Then(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	b := &stubBuilder{
+		args: []reflect.Value{},
+		mock: &instanceMock{
+			mockedCalls: &callsIndex{},
+			t:           new(testing.T),
+			target:      &target,
+		},
+	}
+
+	got := b.Return("first", 1).Then()
+	got.Return("second", 2).Then().ReturnDefaults()
+
+	assert.Equal(t, b, got)
+	assert.False(t, b.mock.t.Failed())
+	assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+	assert.Equal(t, 3, len(b.mock.mockedCalls.calls[0].answers))
+}
+
+func Test_stubBuilder_shouldFailIfCompletedTwiceWithoutThen(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	b := &stubBuilder{
+		args: []reflect.Value{},
+		mock: &instanceMock{
+			mockedCalls: &callsIndex{},
+			t:           new(testing.T),
+			target:      &target,
+		},
+	}
+
+	b.Return("first", 1)
+	b.Return("second", 2)
+
+	assert.True(t, b.mock.t.Failed())
+	assert.Equal(t, 1, len(b.mock.mockedCalls.calls[0].answers))
+}
+
+func Test_stubBuilder_DoAndReturn(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	tests := []struct {
+		name          string
+		completed     bool
+		fn            interface{}
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			completed:     true,
+			fn:            func() (string, int) { return "some-value", 1 },
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the callback is nil",
+			completed:     false,
+			fn:            nil,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the callback is not a function",
+			completed:     false,
+			fn:            "some-value",
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the callback has a different signature",
+			completed:     false,
+			fn:            func() string { return "some-value" },
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should complete the stub",
+			completed:     false,
+			fn:            func() (string, int) { return "some-value", 1 },
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			b.DoAndReturn(tt.fn)
+
+			if tt.shouldSucceed {
+				assert.False(t, b.mock.t.Failed())
+				assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+				out := b.mock.mockedCalls.calls[0].next()(nil)
+				assert.Equal(t, "some-value", out[0].Interface())
+				assert.Equal(t, 1, out[1].Interface())
+
+			} else {
+				assert.True(t, b.mock.t.Failed())
+				assert.Equal(t, 0, len(b.mock.mockedCalls.calls))
+			}
+		})
+	}
+}
+
+func Test_stubBuilder_Panic(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	tests := []struct {
+		name          string
+		completed     bool
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			completed:     true,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should complete the stub",
+			completed:     false,
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			b.Panic("some-value")
+
+			if tt.shouldSucceed {
+				assert.False(t, b.mock.t.Failed())
+				assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+				assert.PanicsWithValue(t, "some-value", func() {
+					b.mock.mockedCalls.calls[0].next()(nil)
+				})
+
+			} else {
+				assert.True(t, b.mock.t.Failed())
+				assert.Equal(t, 0, len(b.mock.mockedCalls.calls))
+			}
+		})
+	}
+}
+
+func Test_stubBuilder_PanicWithError(t *testing.T) {
+	target := reflect.ValueOf(stubBuilderReturnTestFunc01)
+	tests := []struct {
+		name          string
+		completed     bool
+		err           error
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			completed:     true,
+			err:           errors.New("some-error"),
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the error is nil",
+			completed:     false,
+			err:           nil,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should complete the stub",
+			completed:     false,
+			err:           errors.New("some-error"),
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			b.PanicWithError(tt.err)
+
+			if tt.shouldSucceed {
+				assert.False(t, b.mock.t.Failed())
+				assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+				assert.PanicsWithError(t, tt.err.Error(), func() {
+					b.mock.mockedCalls.calls[0].next()(nil)
+				})
+
+			} else {
+				assert.True(t, b.mock.t.Failed())
+				assert.Equal(t, 0, len(b.mock.mockedCalls.calls))
+			}
+		})
+	}
+}
 
-				assert.Equal(t, len(tt.wantMocks.out), len(b.mock.mockedCalls.out))
-				for i := 0; i < len(b.mock.mockedCalls.out); i++ {
-					for j := 0; j < len(b.mock.mockedCalls.out[i]); j++ {
-						assert.Equal(t, tt.wantMocks.out[i][j].Interface(), b.mock.mockedCalls.out[i][j].Interface())
-					}
-				}
+func Test_stubBuilder_SetArg(t *testing.T) {
+	target := reflect.ValueOf(argSetterTestFunc)
+	tests := []struct {
+		name          string
+		completed     bool
+		index         int
+		value         interface{}
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			completed:     true,
+			index:         0,
+			value:         3,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the value can't be set through the argument",
+			completed:     false,
+			index:         0,
+			value:         "some-value",
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should set the argument before computing the outputs",
+			completed:     false,
+			index:         0,
+			value:         3,
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			got := b.SetArg(tt.index, tt.value)
+
+			assert.Equal(t, b, got)
+			assert.Equal(t, tt.shouldSucceed, !b.mock.t.Failed())
+			if !tt.shouldSucceed {
+				assert.Equal(t, 0, len(b.setters))
+				return
+			}
+
+			b.ReturnDefaults()
+			assert.Equal(t, 0, len(b.setters))
+			assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+			value := 0
+			b.mock.mockedCalls.calls[0].next()([]reflect.Value{reflect.ValueOf(&value)})
+			assert.Equal(t, 3, value)
+		})
+	}
+}
+
+func Test_stubBuilder_ReturnArg(t *testing.T) {
+	tests := []struct {
+		name          string
+		target        interface{}
+		completed     bool
+		index         int
+		shouldSucceed bool
+	}{
+		{
+			name:          "Should fail if the stubbing is already completed",
+			target:        strconv.Quote,
+			completed:     true,
+			index:         0,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the function has no outputs",
+			target:        argSetterTestFunc,
+			completed:     false,
+			index:         0,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should fail if the argument can't be returned",
+			target:        strconv.Itoa,
+			completed:     false,
+			index:         0,
+			shouldSucceed: false,
+		},
+		{
+			name:          "Should return the argument and zero values",
+			target:        filepath.Rel,
+			completed:     false,
+			index:         1,
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := reflect.ValueOf(tt.target)
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			b.ReturnArg(tt.index)
+
+			if tt.shouldSucceed {
+				assert.False(t, b.mock.t.Failed())
+				assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+				out := b.mock.mockedCalls.calls[0].next()([]reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b")})
+				assert.Equal(t, "b", out[0].Interface())
+				assert.Nil(t, out[1].Interface())
 
 			} else {
 				assert.True(t, b.mock.t.Failed())
@@ -376,3 +744,109 @@ func Test_stubBuilder_ReturnDefaults(t *testing.T) {
 		})
 	}
 }
+
+func Test_stubBuilder_delays(t *testing.T) {
+	ch := make(chan struct{})
+	close(ch)
+	tests := []struct {
+		name          string
+		target        interface{}
+		completed     bool
+		configure     func(b *stubBuilder) Stub
+		shouldSucceed bool
+	}{
+		{
+			name:          "BlockUntil should fail if the stubbing is already completed",
+			target:        contextErrorIndexTestFunc01,
+			completed:     true,
+			configure:     func(b *stubBuilder) Stub { return b.BlockUntil(ch) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "BlockUntil should add the delay",
+			target:        strconv.Itoa,
+			configure:     func(b *stubBuilder) Stub { return b.BlockUntil(ch) },
+			shouldSucceed: true,
+		},
+		{
+			name:          "BlockUntilContext should fail if the stubbing is already completed",
+			target:        contextErrorIndexTestFunc01,
+			completed:     true,
+			configure:     func(b *stubBuilder) Stub { return b.BlockUntilContext(ch) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "BlockUntilContext should fail if the function doesn't accept a context",
+			target:        strconv.Itoa,
+			configure:     func(b *stubBuilder) Stub { return b.BlockUntilContext(ch) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "BlockUntilContext should add the delay",
+			target:        contextErrorIndexTestFunc01,
+			configure:     func(b *stubBuilder) Stub { return b.BlockUntilContext(ch) },
+			shouldSucceed: true,
+		},
+		{
+			name:          "Delay should fail if the stubbing is already completed",
+			target:        strconv.Itoa,
+			completed:     true,
+			configure:     func(b *stubBuilder) Stub { return b.Delay(time.Millisecond) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "Delay should add the delay",
+			target:        strconv.Itoa,
+			configure:     func(b *stubBuilder) Stub { return b.Delay(time.Millisecond) },
+			shouldSucceed: true,
+		},
+		{
+			name:          "DelayContext should fail if the stubbing is already completed",
+			target:        contextErrorIndexTestFunc01,
+			completed:     true,
+			configure:     func(b *stubBuilder) Stub { return b.DelayContext(time.Millisecond) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "DelayContext should fail if the function doesn't accept a context",
+			target:        strconv.Itoa,
+			configure:     func(b *stubBuilder) Stub { return b.DelayContext(time.Millisecond) },
+			shouldSucceed: false,
+		},
+		{
+			name:          "DelayContext should add the delay",
+			target:        contextErrorIndexTestFunc01,
+			configure:     func(b *stubBuilder) Stub { return b.DelayContext(time.Millisecond) },
+			shouldSucceed: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			target := reflect.ValueOf(tt.target)
+			b := &stubBuilder{
+				args: []reflect.Value{},
+				mock: &instanceMock{
+					defaultOut:  defaultFuncOutput(target.Type()),
+					mockedCalls: &callsIndex{},
+					t:           new(testing.T),
+					target:      &target,
+				},
+				completed: tt.completed,
+			}
+
+			got := tt.configure(b)
+
+			assert.Equal(t, b, got)
+			assert.Equal(t, tt.shouldSucceed, !b.mock.t.Failed())
+			if !tt.shouldSucceed {
+				assert.Equal(t, 0, len(b.delays))
+				return
+			}
+			assert.Equal(t, 1, len(b.delays))
+
+			b.ReturnDefaults()
+			assert.Equal(t, 0, len(b.delays))
+			assert.Equal(t, 1, len(b.mock.mockedCalls.calls))
+		})
+	}
+}
@@ -0,0 +1,16 @@
+package mockit
+
+//go:generate go run gen_funcs.go
+
+// TypedOngoingStub contains methods to configure the answers for consecutive
+// calls of a type-safe stub
+type TypedOngoingStub[S any] struct {
+	ongoing OngoingStub
+	wrap    func(stub Stub) S
+}
+
+// Then configures the answer for the next call; once all the configured
+// answers are used, the last one is repeated
+func (o *TypedOngoingStub[S]) Then() S {
+	return o.wrap(o.ongoing.Then())
+}
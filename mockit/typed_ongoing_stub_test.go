@@ -0,0 +1,22 @@
+package mockit
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_TypedOngoingStub_Then(t *testing.T) {
+	builder := &stubBuilder{completed: true}
+	ongoing := &TypedOngoingStub[*Func0R0Stub]{
+		ongoing: builder,
+		wrap: func(stub Stub) *Func0R0Stub {
+			return &Func0R0Stub{stub: stub}
+		},
+	}
+
+	got := ongoing.Then()
+
+	assert.Equal(t, builder, got.stub)
+	assert.False(t, builder.completed)
+}
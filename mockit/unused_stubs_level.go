@@ -0,0 +1,16 @@
+package mockit
+
+// UnusedStubsLevel defines how stubs that were never used are reported at the
+// end of the test
+type UnusedStubsLevel int
+
+const (
+	// UnusedStubsIgnore doesn't report unused stubs
+	UnusedStubsIgnore UnusedStubsLevel = iota
+
+	// UnusedStubsWarn logs a warning for each unused stub
+	UnusedStubsWarn
+
+	// UnusedStubsFail fails the test for each unused stub
+	UnusedStubsFail
+)
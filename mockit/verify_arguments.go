@@ -0,0 +1,23 @@
+package mockit
+
+import (
+	"fmt"
+	"reflect"
+)
+
+func verifyArguments(typeOf reflect.Type, actualValues []reflect.Value) error {
+	if !typeOf.IsVariadic() {
+		return verifyValues(typeOf.NumIn(), typeOf.In, actualValues)
+	}
+
+	fixedCount := typeOf.NumIn() - 1
+	if len(actualValues) < fixedCount {
+		return fmt.Errorf("Expected values count (%d) is greater than the actual size (%d)", fixedCount, len(actualValues))
+	}
+
+	count := len(actualValues)
+	if count > fixedCount && isVariadicMatcher(actualValues[count-1]) {
+		count--
+	}
+	return verifyValues(count, inTypeProvider(typeOf), actualValues[:count])
+}
@@ -0,0 +1,77 @@
+package mockit
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/pasdam/mockit/matchers/argument"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_verifyArguments(t *testing.T) {
+	tests := []struct {
+		name    string
+		typeOf  reflect.Type
+		values  []reflect.Value
+		wantErr bool
+	}{
+		{
+			name:    "Non variadic function, valid arguments",
+			typeOf:  reflect.TypeOf(os.Setenv),
+			values:  []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b")},
+			wantErr: false,
+		},
+		{
+			name:    "Non variadic function, wrong number of arguments",
+			typeOf:  reflect.TypeOf(os.Setenv),
+			values:  []reflect.Value{reflect.ValueOf("a")},
+			wantErr: true,
+		},
+		{
+			name:    "Variadic function, no variadic arguments",
+			typeOf:  reflect.TypeOf(fmt.Sprintf),
+			values:  []reflect.Value{reflect.ValueOf("a")},
+			wantErr: false,
+		},
+		{
+			name:    "Variadic function, missing fixed arguments",
+			typeOf:  reflect.TypeOf(fmt.Sprintf),
+			values:  []reflect.Value{},
+			wantErr: true,
+		},
+		{
+			name:    "Variadic function, multiple variadic arguments",
+			typeOf:  reflect.TypeOf(filepath.Join),
+			values:  []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf("b"), reflect.ValueOf(argument.Any)},
+			wantErr: false,
+		},
+		{
+			name:    "Variadic function, wrong type of variadic argument",
+			typeOf:  reflect.TypeOf(filepath.Join),
+			values:  []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(1)},
+			wantErr: true,
+		},
+		{
+			name:    "Variadic function, variadic matcher",
+			typeOf:  reflect.TypeOf(fmt.Sprintf),
+			values:  []reflect.Value{reflect.ValueOf("a"), reflect.ValueOf(argument.AnyRemaining)},
+			wantErr: false,
+		},
+		{
+			name:    "Variadic function, variadic matcher in place of a fixed argument",
+			typeOf:  reflect.TypeOf(fmt.Sprintf),
+			values:  []reflect.Value{reflect.ValueOf(argument.AnyRemaining)},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := verifyArguments(tt.typeOf, tt.values)
+
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
@@ -27,7 +27,7 @@ func verifyValues(expectedCount int, expectedValueProvider func(int) reflect.Typ
 				return fmt.Errorf("Cannot assign nil at index %d to the type %v", i, expected)
 			}
 
-		} else if actualValue.Type().AssignableTo(matcherType) {
+		} else if actualValue.Type().AssignableTo(matcherType) || actualValue.Type().Implements(selfDescribingMatcherType) {
 			continue
 		}
 
@@ -92,6 +92,15 @@ func Test_verify_values(t *testing.T) {
 			},
 			wantErr: nil,
 		},
+		{
+			name: "With self-describing matcher",
+			args: args{
+				expectedCount:         1,
+				expectedValueProvider: reflect.TypeOf(os.Getpid).Out,
+				actualValues:          []reflect.Value{reflect.ValueOf(argument.Eq(1))},
+			},
+			wantErr: nil,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {